@@ -1,9 +1,12 @@
 package rlwe
 
 import (
+	"bufio"
 	"fmt"
+	"io"
 
 	"github.com/tuneinsight/lattigo/v5/ring"
+	"github.com/tuneinsight/lattigo/v5/utils/buffer"
 	"github.com/tuneinsight/lattigo/v5/utils/sampling"
 )
 
@@ -57,3 +60,88 @@ func (ct Ciphertext) Copy(ctxCopy *Ciphertext) {
 func (ct Ciphertext) Equal(other *Ciphertext) bool {
 	return ct.Element.Equal(&other.Element)
 }
+
+// BinarySize returns the serialized size of the object in bytes.
+func (ct Ciphertext) BinarySize() (size int) {
+	return 1 + ct.Element.BinarySize()
+}
+
+// WriteTo writes the object on an io.Writer. It implements the io.WriterTo
+// interface, and will write exactly object.BinarySize() bytes on w.
+//
+// Unless w implements the buffer.Writer interface (see lattigo/utils/buffer/writer.go),
+// it will be wrapped into a bufio.Writer. Since this requires allocations, it
+// is preferable to pass a buffer.Writer directly:
+//
+//   - When writing multiple times to a io.Writer, it is preferable to first wrap the
+//     io.Writer in a pre-allocated bufio.Writer.
+//   - When writing to a pre-allocated var b []byte, it is preferable to pass
+//     buffer.NewBuffer(b) as w (see lattigo/utils/buffer/buffer.go).
+//
+// The first byte written is BinaryMarshalingVersion.
+func (ct Ciphertext) WriteTo(w io.Writer) (n int64, err error) {
+	switch w := w.(type) {
+	case buffer.Writer:
+
+		var inc int64
+
+		if inc, err = writeVersion(w); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		inc, err = ct.Element.WriteTo(w)
+
+		return n + inc, err
+
+	default:
+		return ct.WriteTo(bufio.NewWriter(w))
+	}
+}
+
+// ReadFrom reads on the object from an io.Writer. It implements the
+// io.ReaderFrom interface.
+//
+// Unless r implements the buffer.Reader interface (see see lattigo/utils/buffer/reader.go),
+// it will be wrapped into a bufio.Reader. Since this requires allocation, it
+// is preferable to pass a buffer.Reader directly:
+//
+//   - When reading multiple values from a io.Reader, it is preferable to first
+//     first wrap io.Reader in a pre-allocated bufio.Reader.
+//   - When reading from a var b []byte, it is preferable to pass a buffer.NewBuffer(b)
+//     as w (see lattigo/utils/buffer/buffer.go).
+func (ct *Ciphertext) ReadFrom(r io.Reader) (n int64, err error) {
+	switch r := r.(type) {
+	case buffer.Reader:
+
+		var inc int64
+
+		if inc, err = readVersion(r); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		inc, err = ct.Element.ReadFrom(r)
+
+		return n + inc, err
+
+	default:
+		return ct.ReadFrom(bufio.NewReader(r))
+	}
+}
+
+// MarshalBinary encodes the object into a binary form on a newly allocated slice of bytes.
+func (ct Ciphertext) MarshalBinary() (p []byte, err error) {
+	buf := buffer.NewBufferSize(ct.BinarySize())
+	_, err = ct.WriteTo(buf)
+	return buf.Bytes(), err
+}
+
+// UnmarshalBinary decodes a slice of bytes generated by
+// MarshalBinary or WriteTo on the object.
+func (ct *Ciphertext) UnmarshalBinary(p []byte) (err error) {
+	_, err = ct.ReadFrom(buffer.NewBuffer(p))
+	return
+}
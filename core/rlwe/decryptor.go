@@ -89,6 +89,22 @@ func (d Decryptor) Decrypt(ct *Ciphertext, pt *Plaintext) {
 	}
 }
 
+// CanDecrypt returns false if decrypting ct is unlikely to yield a meaningful result: specifically,
+// when ct is at its lowest level (no modulus left to drop on a future operation) and its scale has
+// dropped to, or below, the single modulus remaining at that level. Past that point, the scaled
+// message and the accumulated noise can no longer be told apart by rounding, so Decrypt/DecryptNew
+// would return noise rather than a usable approximation of the original value.
+//
+// CanDecrypt is a heuristic: a true result is not a guarantee that decryption returns an accurate
+// value (the noise could already be large enough to corrupt the result at a higher level too), and it
+// does not apply to schemes, such as BFV and BGV, that encode without a scale.
+func (d Decryptor) CanDecrypt(ct *Ciphertext) bool {
+	if ct.Level() > 0 {
+		return true
+	}
+	return ct.Scale.Cmp(NewScale(d.ringQ.SubRings[0].Modulus)) > 0
+}
+
 // ShallowCopy creates a shallow copy of Decryptor in which all the read-only data-structures are
 // shared with the receiver and the temporary buffers are reallocated. The receiver and the returned
 // Decryptor can be used concurrently.
@@ -69,6 +69,15 @@ func newEncryptor(params Parameters) *Encryptor {
 		panic(err)
 	}
 
+	return newEncryptorWithPRNG(params, prng)
+}
+
+// newEncryptorWithPRNG creates a new Encryptor for which the uniform element c1 and the error are both
+// sampled from prng, rather than from a freshly-seeded one. It is used by newEncryptor, and by
+// KeyGenerator.GenPublicKeyDeterministic to make every random value drawn while encrypting a
+// zero plaintext reproducible from prng's seed.
+func newEncryptorWithPRNG(params Parameters, prng sampling.PRNG) *Encryptor {
+
 	var bc *ring.BasisExtender
 	if params.PCount() != 0 {
 		bc = ring.NewBasisExtender(params.RingQ(), params.RingP())
@@ -165,6 +174,52 @@ func (enc Encryptor) EncryptNew(pt *Plaintext) (ct *Ciphertext, err error) {
 	return ct, enc.Encrypt(pt, ct)
 }
 
+// EncryptBatch encrypts each plaintext in pts using the stored encryption key and returns the
+// resulting ciphertexts, in the same order as pts.
+//
+// This is provided as a convenience over calling EncryptNew in a loop: since a single Encryptor
+// already owns its scratch buffers and, when encrypting under a PublicKey, that key's NTT-domain
+// representation is already precomputed once at key-generation time, calling EncryptNew repeatedly
+// on the same Encryptor already reuses all of that state across the batch. EncryptBatch does not
+// add any further precomputation sharing on top of that, and in particular does not share any
+// randomness between ciphertexts: each plaintext still gets an independently sampled encryption of
+// zero, as required for CPA security.
+func (enc Encryptor) EncryptBatch(pts []*Plaintext) (cts []*Ciphertext, err error) {
+	cts = make([]*Ciphertext, len(pts))
+	for i, pt := range pts {
+		if cts[i], err = enc.EncryptNew(pt); err != nil {
+			return nil, fmt.Errorf("cannot EncryptBatch: plaintext %d: %w", i, err)
+		}
+	}
+	return
+}
+
+// EncryptPreprocessed behaves like EncryptNew, except that it requires pt to already be in the
+// NTT domain expected by the parameters (see Parameters.NTTFlag), and returns an error instead of
+// encrypting if that is not the case.
+//
+// EncryptNew always matches the output ciphertext's domain to pt's domain, so it never pays for a
+// redundant conversion when encrypting the same preprocessed plaintext several times. What it does
+// not catch is a plaintext that was never put in the expected domain to begin with, e.g. because the
+// caller forgot to set IsNTT (and, if relevant, IsMontgomery) before encoding it: EncryptNew would
+// silently produce a ciphertext in that same, unexpected domain. EncryptPreprocessed is intended for
+// that repeated-encryption use case, where catching a missed preprocessing step early is preferable
+// to a ciphertext that decrypts fine but is in the wrong domain for what the caller expects.
+func (enc Encryptor) EncryptPreprocessed(pt *Plaintext) (ct *Ciphertext, err error) {
+
+	if pt.IsNTT != enc.params.NTTFlag() {
+		return nil, fmt.Errorf("cannot EncryptPreprocessed: pt.IsNTT = %t does not match the expected domain (NTTFlag = %t): encode pt in the expected domain or use Encrypt/EncryptNew instead", pt.IsNTT, enc.params.NTTFlag())
+	}
+
+	ct = NewCiphertext(enc.params, 1, pt.Level())
+
+	if err = enc.Encrypt(pt, ct); err != nil {
+		return nil, fmt.Errorf("cannot EncryptPreprocessed: %w", err)
+	}
+
+	return ct, nil
+}
+
 // EncryptZero generates an encryption of zero under the stored encryption key and writes the result on ct.
 // The method accepts only *rlwe.Ciphertext as input.
 // The method returns an error if the ct has an unsupported type or if no encryption key is stored
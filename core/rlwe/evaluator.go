@@ -6,6 +6,7 @@ import (
 	"github.com/tuneinsight/lattigo/v5/ring"
 	"github.com/tuneinsight/lattigo/v5/ring/ringqp"
 	"github.com/tuneinsight/lattigo/v5/utils"
+	"github.com/tuneinsight/lattigo/v5/utils/sampling"
 )
 
 // Evaluator is a struct that holds the necessary elements to execute general homomorphic
@@ -19,6 +20,12 @@ type Evaluator struct {
 
 	BasisExtender *ring.BasisExtender
 	Decomposer    *ring.Decomposer
+
+	// noiseFlooding, if not nil, is added to the output of Automorphism and ApplyEvaluationKey,
+	// see WithNoiseFlooding. noiseFloodingDist is kept alongside it so that ShallowCopy can
+	// instantiate an independent sampler for the copy.
+	noiseFlooding     ring.Sampler
+	noiseFloodingDist ring.DistributionParameters
 }
 
 type EvaluatorBuffers struct {
@@ -140,6 +147,21 @@ func (eval Evaluator) CheckAndGetRelinearizationKey() (evk *RelinearizationKey,
 	return
 }
 
+// checkRingDegree returns an error if any of ops was not allocated for the same ring degree N as eval's
+// Parameters. This is the dominant symptom of mixing a ciphertext encrypted under one set of Parameters
+// with an Evaluator instantiated for another: without this check, the mismatch surfaces later as an
+// out-of-range panic or silently wrong arithmetic deep inside the ring package, rather than as a clear
+// error at the point the operation was issued.
+func (eval Evaluator) checkRingDegree(ops ...*Element[ring.Poly]) error {
+	N := eval.params.RingQ().N()
+	for _, op := range ops {
+		if n := len(op.Value[0].Coeffs[0]); n != N {
+			return fmt.Errorf("cannot operate on op with ring degree N=%d: evaluator was instantiated with N=%d (op was likely encrypted/allocated under different Parameters)", n, N)
+		}
+	}
+	return nil
+}
+
 // InitOutputBinaryOp initializes the output Element opOut for receiving the result of a binary operation over
 // op0 and op1. The method also performs the following checks:
 //
@@ -148,6 +170,7 @@ func (eval Evaluator) CheckAndGetRelinearizationKey() (evk *RelinearizationKey,
 // 3. op0.Degree() + op1.Degree() != 0 (i.e at least one Element is a ciphertext)
 // 4. op0.IsNTT == op1.IsNTT == DefaultNTTFlag
 // 5. op0.IsBatched == op1.IsBatched
+// 6. op0, op1 and opOut were all allocated for the same ring degree N as the Evaluator's Parameters
 //
 // The opOut metadata are initilized as:
 // IsNTT <- DefaultNTTFlag
@@ -165,6 +188,10 @@ func (eval Evaluator) InitOutputBinaryOp(op0, op1 *Element[ring.Poly], opInTotal
 		return 0, 0, fmt.Errorf("op0, op1 and opOut MetaData cannot be nil")
 	}
 
+	if err = eval.checkRingDegree(op0, op1, opOut); err != nil {
+		return 0, 0, err
+	}
+
 	degree = utils.Max(op0.Degree(), op1.Degree())
 	degree = utils.Max(degree, opOut.Degree())
 	level = utils.Min(op0.Level(), op1.Level())
@@ -204,6 +231,7 @@ func (eval Evaluator) InitOutputBinaryOp(op0, op1 *Element[ring.Poly], opInTotal
 // 1. Input and output are not nil
 // 2. Inoutp and output Metadata are not nil
 // 2. op0.IsNTT == DefaultNTTFlag
+// 3. op0 and opOut were both allocated for the same ring degree N as the Evaluator's Parameters
 //
 // The method will also update the metadata of opOut:
 //
@@ -222,6 +250,10 @@ func (eval Evaluator) InitOutputUnaryOp(op0, opOut *Element[ring.Poly]) (degree,
 		return 0, 0, fmt.Errorf("op0 and opOut MetaData cannot be nil")
 	}
 
+	if err = eval.checkRingDegree(op0, opOut); err != nil {
+		return 0, 0, err
+	}
+
 	if op0.El().IsNTT != eval.params.NTTFlag() {
 		return 0, 0, fmt.Errorf("op0.IsNTT() != %t", eval.params.NTTFlag())
 	} else {
@@ -238,6 +270,21 @@ func (eval Evaluator) InitOutputUnaryOp(op0, opOut *Element[ring.Poly]) (degree,
 // shared with the receiver and the temporary buffers are reallocated. The receiver and the returned
 // Evaluators can be used concurrently.
 func (eval Evaluator) ShallowCopy() *Evaluator {
+
+	var noiseFlooding ring.Sampler
+	if eval.noiseFlooding != nil {
+		prng, err := sampling.NewPRNG()
+		// Sanity check, this error should not happen.
+		if err != nil {
+			panic(err)
+		}
+
+		if noiseFlooding, err = ring.NewSampler(prng, eval.params.RingQ(), eval.noiseFloodingDist, false); err != nil {
+			// Sanity check, this error should not happen: it would already have failed in WithNoiseFlooding.
+			panic(err)
+		}
+	}
+
 	return &Evaluator{
 		params:            eval.params,
 		Decomposer:        eval.Decomposer,
@@ -245,6 +292,8 @@ func (eval Evaluator) ShallowCopy() *Evaluator {
 		EvaluatorBuffers:  NewEvaluatorBuffers(eval.params),
 		EvaluationKeySet:  eval.EvaluationKeySet,
 		automorphismIndex: eval.automorphismIndex,
+		noiseFlooding:     noiseFlooding,
+		noiseFloodingDist: eval.noiseFloodingDist,
 	}
 }
 
@@ -276,6 +325,58 @@ func (eval Evaluator) WithKey(evk EvaluationKeySet) *Evaluator {
 		BasisExtender:     eval.BasisExtender,
 		EvaluationKeySet:  evk,
 		automorphismIndex: AutomorphismIndex,
+		noiseFlooding:     eval.noiseFlooding,
+		noiseFloodingDist: eval.noiseFloodingDist,
+	}
+}
+
+// WithNoiseFlooding returns a shallow copy of the receiver Evaluator that adds extra Gaussian
+// smudging noise, sampled according to noise, to the output of Automorphism and
+// ApplyEvaluationKey (and hence of the methods built on top of them, such as Rotate,
+// Conjugate and Relinearize's key-switching counterparts). This can be used to achieve
+// circuit privacy: flooding the re-encrypted ciphertext with enough noise makes the result
+// statistically close to a fresh encryption, hiding the function that produced it from a
+// party able to decrypt it.
+//
+// By default, i.e. without calling this method, no additional noise is added.
+func (eval Evaluator) WithNoiseFlooding(noise ring.DistributionParameters) (*Evaluator, error) {
+
+	prng, err := sampling.NewPRNG()
+	if err != nil {
+		return nil, err
+	}
+
+	sampler, err := ring.NewSampler(prng, eval.params.RingQ(), noise, false)
+	if err != nil {
+		return nil, fmt.Errorf("cannot WithNoiseFlooding: %w", err)
+	}
+
+	return &Evaluator{
+		params:            eval.params,
+		EvaluationKeySet:  eval.EvaluationKeySet,
+		EvaluatorBuffers:  eval.EvaluatorBuffers,
+		automorphismIndex: eval.automorphismIndex,
+		BasisExtender:     eval.BasisExtender,
+		Decomposer:        eval.Decomposer,
+		noiseFlooding:     sampler,
+		noiseFloodingDist: noise,
+	}, nil
+}
+
+// addNoiseFlooding adds the Evaluator's noise flooding sample, if any was configured with
+// WithNoiseFlooding, to ct.Value[0] at the given level.
+func (eval Evaluator) addNoiseFlooding(level int, ct *Ciphertext) {
+
+	if eval.noiseFlooding == nil {
+		return
+	}
+
+	if ct.IsNTT {
+		eval.noiseFlooding.AtLevel(level).Read(eval.BuffInvNTT)
+		eval.params.RingQ().AtLevel(level).NTT(eval.BuffInvNTT, eval.BuffInvNTT)
+		eval.params.RingQ().AtLevel(level).Add(ct.Value[0], eval.BuffInvNTT, ct.Value[0])
+	} else {
+		eval.noiseFlooding.AtLevel(level).ReadAndAdd(ct.Value[0])
 	}
 }
 
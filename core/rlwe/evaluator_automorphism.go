@@ -50,6 +50,8 @@ func (eval Evaluator) Automorphism(ctIn *Ciphertext, galEl uint64, opOut *Cipher
 		ringQ.Automorphism(ctTmp.Value[1], galEl, opOut.Value[1])
 	}
 
+	eval.addNoiseFlooding(level, opOut)
+
 	*opOut.MetaData = *ctIn.MetaData
 
 	return
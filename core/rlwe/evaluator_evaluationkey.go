@@ -97,6 +97,8 @@ func (eval Evaluator) ApplyEvaluationKey(ctIn *Ciphertext, evk *EvaluationKey, o
 		eval.applyEvaluationKey(level, ctIn, evk, opOut)
 	}
 
+	eval.addNoiseFlooding(level, opOut)
+
 	*opOut.MetaData = *ctIn.MetaData
 
 	return
@@ -313,6 +313,13 @@ func (eval Evaluator) InnerFunction(ctIn *Ciphertext, batchSize, n int, f func(a
 
 // GaloisElementsForInnerSum returns the list of Galois elements necessary to apply the method
 // `InnerSum` operation with parameters `batch` and `n`.
+//
+// It returns exactly the baby-step (batch*2^i) and giant-step (batch*(n - n mod 2^{i+1})) rotations
+// InnerSum's tree visits for i ranging over the powers of two below n, deduplicated: no more than
+// 2*ceil(log2(n)) elements (e.g. 10 for n=100). Pass the result to KeyGenerator.GenGaloisKeysNew to generate exactly the rlwe.GaloisKeys
+// the inner-sum tree needs for those parameters; this repository has no InnerSum-specific key
+// generation method because Galois key generation is generic over any Galois element list, shared
+// by every tree-shaped rotation pattern (InnerSum, Replicate, GaloisElementsFor2DGrid, ...).
 func GaloisElementsForInnerSum(params ParameterProvider, batch, n int) (galEls []uint64) {
 
 	rotIndex := make(map[int]bool)
@@ -355,3 +362,22 @@ func (eval Evaluator) Replicate(ctIn *Ciphertext, batchSize, n int, opOut *Ciphe
 func GaloisElementsForReplicate(params ParameterProvider, batch, n int) (galEls []uint64) {
 	return GaloisElementsForInnerSum(params, -batch, n)
 }
+
+// GaloisElementsFor2DGrid returns the list of Galois elements necessary to apply `InnerSum` (or
+// `Replicate`) along the rows (batch=1, n=cols) and along the columns (batch=cols, n=rows) of data
+// laid out in a row-major 2D grid of rows*cols elements, deduplicated between the two directions.
+func GaloisElementsFor2DGrid(params ParameterProvider, rows, cols int) (galEls []uint64) {
+
+	rowEls := GaloisElementsForInnerSum(params, 1, cols)
+	colEls := GaloisElementsForInnerSum(params, cols, rows)
+
+	seen := make(map[uint64]bool, len(rowEls)+len(colEls))
+	for _, galEl := range append(rowEls, colEls...) {
+		if !seen[galEl] {
+			seen[galEl] = true
+			galEls = append(galEls, galEl)
+		}
+	}
+
+	return galEls
+}
@@ -2,10 +2,13 @@ package rlwe
 
 import (
 	"fmt"
+	"runtime"
+	"sync"
 
 	"github.com/tuneinsight/lattigo/v5/ring"
 	"github.com/tuneinsight/lattigo/v5/ring/ringqp"
 	"github.com/tuneinsight/lattigo/v5/utils"
+	"github.com/tuneinsight/lattigo/v5/utils/sampling"
 )
 
 // KeyGenerator is a structure that stores the elements required to create new keys,
@@ -21,6 +24,13 @@ func NewKeyGenerator(params ParameterProvider) *KeyGenerator {
 	}
 }
 
+// ShallowCopy creates a shallow copy of this KeyGenerator in which all the read-only data-structures
+// are shared with the receiver and the temporary buffers are reallocated. The receiver and the
+// returned KeyGenerators can be used concurrently.
+func (kgen KeyGenerator) ShallowCopy() *KeyGenerator {
+	return &KeyGenerator{Encryptor: kgen.Encryptor.ShallowCopy()}
+}
+
 // GenSecretKeyNew generates a new SecretKey.
 // Distribution is set according to `rlwe.Parameters.HammingWeight()`.
 func (kgen KeyGenerator) GenSecretKeyNew() (sk *SecretKey) {
@@ -52,6 +62,35 @@ func (kgen KeyGenerator) GenSecretKeyWithHammingWeight(hw int, sk *SecretKey) {
 	kgen.genSecretKeyFromSampler(Xs, sk)
 }
 
+// GenSecretKeyWithDistributionNew generates a new SecretKey whose coefficients follow dist instead of
+// the distribution set by rlwe.Parameters.Xs(). dist is one of the implementations of
+// ring.DistributionParameters: ring.Ternary{P: p} for a uniform ternary secret (each coefficient in
+// {-1, 0, 1} with probability p of being non-zero, the standard choice, see DefaultXs),
+// ring.Ternary{H: h} for a sparse ternary secret of fixed Hamming weight h (the same distribution as
+// GenSecretKeyWithHammingWeight), or ring.DiscreteGaussian{Sigma: s, Bound: b} for a Gaussian secret.
+// Changing the secret distribution changes the security level of the parameters: the uniform ternary
+// and Gaussian choices are covered by the usual LWE-estimator-based security analysis as a function of
+// their standard deviation (XsUniformTernary for Ternary{P: 2/3.0}, Sigma for DiscreteGaussian), while a
+// sparse secret of Hamming weight h is instead analyzed combinatorially as a function of h and must be
+// re-estimated for the target parameter set; it is not simply "more secure" for larger h.
+func (kgen KeyGenerator) GenSecretKeyWithDistributionNew(dist ring.DistributionParameters) (sk *SecretKey) {
+	sk = NewSecretKey(kgen.params)
+	kgen.GenSecretKeyWithDistribution(dist, sk)
+	return
+}
+
+// GenSecretKeyWithDistribution generates a SecretKey whose coefficients follow dist instead of the
+// distribution set by rlwe.Parameters.Xs(). See GenSecretKeyWithDistributionNew for the supported
+// values of dist and their effect on the security estimate.
+func (kgen KeyGenerator) GenSecretKeyWithDistribution(dist ring.DistributionParameters, sk *SecretKey) {
+	Xs, err := ring.NewSampler(kgen.prng, kgen.params.RingQ(), dist, false)
+	// Sanity check, this error should not happen.
+	if err != nil {
+		panic(err)
+	}
+	kgen.genSecretKeyFromSampler(Xs, sk)
+}
+
 func (kgen KeyGenerator) genSecretKeyFromSampler(sampler ring.Sampler, sk *SecretKey) {
 
 	ringQP := kgen.params.RingQP().AtLevel(sk.LevelQ(), sk.LevelP())
@@ -84,6 +123,36 @@ func (kgen KeyGenerator) GenPublicKey(sk *SecretKey, pk *PublicKey) {
 	}
 }
 
+// genPublicKeyWithPRNG generates a public key from the provided SecretKey, sampling the
+// encryption-of-zero's uniform element and error from prng instead of from kgen's own PRNG.
+func (kgen KeyGenerator) genPublicKeyWithPRNG(sk *SecretKey, prng sampling.PRNG, pk *PublicKey) {
+	enc := newEncryptorWithPRNG(kgen.params, prng)
+	if err := enc.WithKey(sk).EncryptZero(Element[ringqp.Poly]{
+		MetaData: &MetaData{CiphertextMetaData: CiphertextMetaData{IsNTT: true, IsMontgomery: true}},
+		Value:    []ringqp.Poly(pk.Value),
+	}); err != nil {
+		// Sanity check, this error should not happen.
+		panic(err)
+	}
+}
+
+// GenPublicKeyDeterministic generates a new public key from sk, sampling the encryption-of-zero's
+// uniform element and error from a PRNG keyed with seed, instead of from entropy. Two calls with the
+// same sk and seed therefore always produce the same PublicKey, which allows a public key to be
+// recomputed on demand from a SecretKey and a seed rather than stored alongside it.
+func (kgen KeyGenerator) GenPublicKeyDeterministic(sk *SecretKey, seed []byte) (pk *PublicKey) {
+
+	prng, err := sampling.NewKeyedPRNG(seed)
+	// Sanity check, this error should not happen.
+	if err != nil {
+		panic(err)
+	}
+
+	pk = NewPublicKey(kgen.params)
+	kgen.genPublicKeyWithPRNG(sk, prng, pk)
+	return
+}
+
 // GenKeyPairNew generates a new SecretKey and a corresponding public key.
 // Distribution is of the SecretKey set according to `rlwe.Parameters.HammingWeight()`.
 func (kgen KeyGenerator) GenKeyPairNew() (sk *SecretKey, pk *PublicKey) {
@@ -186,6 +255,58 @@ func (kgen KeyGenerator) GenGaloisKeysNew(galEls []uint64, sk *SecretKey, evkPar
 	return
 }
 
+// GenAllKeysParallel generates a RelinearizationKey and the GaloisKeys for every Galois element in
+// galEls, splitting the work across workers goroutines, and returns them bundled in a
+// MemEvaluationKeySet ready to be handed to an Evaluator. If workers <= 0, it defaults to
+// runtime.GOMAXPROCS(0).
+//
+// Key generation is CPU-bound and each key is independent of the others, so this produces the same
+// kind of keys, with the same parameters and noise, as generating them one at a time with
+// GenRelinearizationKeyNew and GenGaloisKeyNew (each key is freshly randomized, so the bytes differ
+// from one call to the next), just spread across multiple cores. galEls is taken as an explicit slice, like GenGaloisKeysNew, rather
+// than this method picking a fixed set of rotations itself: callers that need, e.g., the
+// power-of-two rotations and the complex-conjugation automorphism for CKKS can build galEls with
+// Parameters.GaloisElements and Parameters.GaloisElementForComplexConjugation (schemes/ckks) and pass
+// the result in directly.
+func (kgen KeyGenerator) GenAllKeysParallel(sk *SecretKey, galEls []uint64, workers int) (evk *MemEvaluationKeySet) {
+
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	var rlk *RelinearizationKey
+	gks := make([]*GaloisKey, len(galEls))
+
+	jobs := make(chan int, len(galEls))
+	for i := range galEls {
+		jobs <- i
+	}
+	close(jobs)
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		rlk = kgen.ShallowCopy().GenRelinearizationKeyNew(sk)
+	}()
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			kgenLocal := kgen.ShallowCopy()
+			for i := range jobs {
+				gks[i] = kgenLocal.GenGaloisKeyNew(galEls[i], sk)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return NewMemEvaluationKeySet(rlk, gks...)
+}
+
 // GenEvaluationKeysForRingSwapNew generates the necessary EvaluationKeys to switch from a standard ring to to a conjugate invariant ring and vice-versa.
 func (kgen KeyGenerator) GenEvaluationKeysForRingSwapNew(skStd, skConjugateInvariant *SecretKey, evkParams ...EvaluationKeyParameters) (stdToci, ciToStd *EvaluationKey) {
 
@@ -209,6 +330,27 @@ func (kgen KeyGenerator) GenEvaluationKeysForRingSwapNew(skStd, skConjugateInvar
 	return
 }
 
+// GenParameterSwitchingKeyNew generates a new EvaluationKey to move a Ciphertext generated under one
+// set of Parameters into another set of Parameters of the same ring degree, for example to move
+// from a Parameters with more levels to one with fewer. skSource is the secret-key of the Parameters
+// ctIn is currently encrypted under, paramsTarget is the Parameters to move to, and skTarget is the
+// secret-key ctIn must be re-encrypted under in that Parameters. It returns an error if paramsTarget
+// does not have the same ring degree as the receiver.
+//
+// As with GenEvaluationKeyNew, the receiver KeyGenerator must be the one instantiated from whichever
+// of the two Parameters has the larger modulus chain (see, e.g., the sparse/dense secret-switching
+// keys generated by the bootstrapping package for the same pattern), since that is the ring in which
+// the returned EvaluationKey is generated. The returned EvaluationKey can then be used with
+// Evaluator.ApplyEvaluationKey, bound to that same larger Parameters, to move ctIn to paramsTarget.
+func (kgen KeyGenerator) GenParameterSwitchingKeyNew(skSource *SecretKey, paramsTarget ParameterProvider, skTarget *SecretKey, evkParams ...EvaluationKeyParameters) (evk *EvaluationKey, err error) {
+
+	if N, NTarget := kgen.params.N(), paramsTarget.GetRLWEParameters().N(); N != NTarget {
+		return nil, fmt.Errorf("cannot GenParameterSwitchingKeyNew: paramsTarget ring degree (N=%d) does not match the receiver's (N=%d)", NTarget, N)
+	}
+
+	return kgen.GenEvaluationKeyNew(skSource, skTarget, evkParams...), nil
+}
+
 // GenEvaluationKeyNew generates a new EvaluationKey, that will re-encrypt a Ciphertext encrypted under the input key into the output key.
 // If the ringDegree(skOutput) > ringDegree(skInput),  generates [-a*SkOut + w*P*skIn_{Y^{N/n}} + e, a] in X^{N}.
 // If the ringDegree(skOutput) < ringDegree(skInput),  generates [-a*skOut_{Y^{N/n}} + w*P*skIn + e_{N}, a_{N}] in X^{N}.
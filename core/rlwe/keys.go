@@ -44,7 +44,7 @@ func (sk SecretKey) CopyNew() *SecretKey {
 
 // BinarySize returns the serialized size of the object in bytes.
 func (sk SecretKey) BinarySize() (dataLen int) {
-	return sk.Value.BinarySize()
+	return 1 + sk.Value.BinarySize()
 }
 
 // WriteTo writes the object on an io.Writer. It implements the io.WriterTo
@@ -58,8 +58,27 @@ func (sk SecretKey) BinarySize() (dataLen int) {
 //     io.Writer in a pre-allocated bufio.Writer.
 //   - When writing to a pre-allocated var b []byte, it is preferable to pass
 //     buffer.NewBuffer(b) as w (see lattigo/utils/buffer/buffer.go).
+//
+// The first byte written is BinaryMarshalingVersion.
 func (sk SecretKey) WriteTo(w io.Writer) (n int64, err error) {
-	return sk.Value.WriteTo(w)
+	switch w := w.(type) {
+	case buffer.Writer:
+
+		var inc int64
+
+		if inc, err = writeVersion(w); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		inc, err = sk.Value.WriteTo(w)
+
+		return n + inc, err
+
+	default:
+		return sk.WriteTo(bufio.NewWriter(w))
+	}
 }
 
 // ReadFrom reads on the object from an io.Writer. It implements the
@@ -73,19 +92,41 @@ func (sk SecretKey) WriteTo(w io.Writer) (n int64, err error) {
 //     first wrap io.Reader in a pre-allocated bufio.Reader.
 //   - When reading from a var b []byte, it is preferable to pass a buffer.NewBuffer(b)
 //     as w (see lattigo/utils/buffer/buffer.go).
+//
+// An error is returned if the first byte read is not BinaryMarshalingVersion.
 func (sk *SecretKey) ReadFrom(r io.Reader) (n int64, err error) {
-	return sk.Value.ReadFrom(r)
+	switch r := r.(type) {
+	case buffer.Reader:
+
+		var inc int64
+
+		if inc, err = readVersion(r); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		inc, err = sk.Value.ReadFrom(r)
+
+		return n + inc, err
+
+	default:
+		return sk.ReadFrom(bufio.NewReader(r))
+	}
 }
 
 // MarshalBinary encodes the object into a binary form on a newly allocated slice of bytes.
 func (sk SecretKey) MarshalBinary() (p []byte, err error) {
-	return sk.Value.MarshalBinary()
+	buf := buffer.NewBufferSize(sk.BinarySize())
+	_, err = sk.WriteTo(buf)
+	return buf.Bytes(), err
 }
 
 // UnmarshalBinary decodes a slice of bytes generated by
 // MarshalBinary or WriteTo on the object.
 func (sk *SecretKey) UnmarshalBinary(p []byte) (err error) {
-	return sk.Value.UnmarshalBinary(p)
+	_, err = sk.ReadFrom(buffer.NewBuffer(p))
+	return
 }
 
 func (sk *SecretKey) isEncryptionKey() {}
@@ -231,7 +272,7 @@ func (p PublicKey) Equal(other *PublicKey) bool {
 }
 
 func (p PublicKey) BinarySize() int {
-	return p.Value.BinarySize()
+	return 1 + p.Value.BinarySize()
 }
 
 // WriteTo writes the object on an io.Writer. It implements the io.WriterTo
@@ -245,8 +286,27 @@ func (p PublicKey) BinarySize() int {
 //     io.Writer in a pre-allocated bufio.Writer.
 //   - When writing to a pre-allocated var b []byte, it is preferable to pass
 //     buffer.NewBuffer(b) as w (see lattigo/utils/buffer/buffer.go).
+//
+// The first byte written is BinaryMarshalingVersion.
 func (p PublicKey) WriteTo(w io.Writer) (n int64, err error) {
-	return p.Value.WriteTo(w)
+	switch w := w.(type) {
+	case buffer.Writer:
+
+		var inc int64
+
+		if inc, err = writeVersion(w); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		inc, err = p.Value.WriteTo(w)
+
+		return n + inc, err
+
+	default:
+		return p.WriteTo(bufio.NewWriter(w))
+	}
 }
 
 // ReadFrom reads on the object from an io.Writer. It implements the
@@ -260,19 +320,41 @@ func (p PublicKey) WriteTo(w io.Writer) (n int64, err error) {
 //     first wrap io.Reader in a pre-allocated bufio.Reader.
 //   - When reading from a var b []byte, it is preferable to pass a buffer.NewBuffer(b)
 //     as w (see lattigo/utils/buffer/buffer.go).
+//
+// An error is returned if the first byte read is not BinaryMarshalingVersion.
 func (p *PublicKey) ReadFrom(r io.Reader) (n int64, err error) {
-	return p.Value.ReadFrom(r)
+	switch r := r.(type) {
+	case buffer.Reader:
+
+		var inc int64
+
+		if inc, err = readVersion(r); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		inc, err = p.Value.ReadFrom(r)
+
+		return n + inc, err
+
+	default:
+		return p.ReadFrom(bufio.NewReader(r))
+	}
 }
 
 // MarshalBinary encodes the object into a binary form on a newly allocated slice of bytes.
 func (p PublicKey) MarshalBinary() ([]byte, error) {
-	return p.Value.MarshalBinary()
+	buf := buffer.NewBufferSize(p.BinarySize())
+	_, err := p.WriteTo(buf)
+	return buf.Bytes(), err
 }
 
 // UnmarshalBinary decodes a slice of bytes generated by
 // MarshalBinary or WriteTo on the object.
 func (p *PublicKey) UnmarshalBinary(b []byte) error {
-	return p.Value.UnmarshalBinary(b)
+	_, err := p.ReadFrom(buffer.NewBuffer(b))
+	return err
 }
 
 func (p *PublicKey) isEncryptionKey() {}
@@ -344,6 +426,93 @@ func (evk EvaluationKey) Equal(other *EvaluationKey) bool {
 	return evk.GadgetCiphertext.Equal(&other.GadgetCiphertext)
 }
 
+// BinarySize returns the serialized size of the object in bytes.
+func (evk EvaluationKey) BinarySize() int {
+	return 1 + evk.GadgetCiphertext.BinarySize()
+}
+
+// WriteTo writes the object on an io.Writer. It implements the io.WriterTo
+// interface, and will write exactly object.BinarySize() bytes on w.
+//
+// Unless w implements the buffer.Writer interface (see lattigo/utils/buffer/writer.go),
+// it will be wrapped into a bufio.Writer. Since this requires allocations, it
+// is preferable to pass a buffer.Writer directly:
+//
+//   - When writing multiple times to a io.Writer, it is preferable to first wrap the
+//     io.Writer in a pre-allocated bufio.Writer.
+//   - When writing to a pre-allocated var b []byte, it is preferable to pass
+//     buffer.NewBuffer(b) as w (see lattigo/utils/buffer/buffer.go).
+//
+// The first byte written is BinaryMarshalingVersion.
+func (evk EvaluationKey) WriteTo(w io.Writer) (n int64, err error) {
+	switch w := w.(type) {
+	case buffer.Writer:
+
+		var inc int64
+
+		if inc, err = writeVersion(w); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		inc, err = evk.GadgetCiphertext.WriteTo(w)
+
+		return n + inc, err
+
+	default:
+		return evk.WriteTo(bufio.NewWriter(w))
+	}
+}
+
+// ReadFrom reads on the object from an io.Writer. It implements the
+// io.ReaderFrom interface.
+//
+// Unless r implements the buffer.Reader interface (see see lattigo/utils/buffer/reader.go),
+// it will be wrapped into a bufio.Reader. Since this requires allocation, it
+// is preferable to pass a buffer.Reader directly:
+//
+//   - When reading multiple values from a io.Reader, it is preferable to first
+//     first wrap io.Reader in a pre-allocated bufio.Reader.
+//   - When reading from a var b []byte, it is preferable to pass a buffer.NewBuffer(b)
+//     as w (see lattigo/utils/buffer/buffer.go).
+//
+// An error is returned if the first byte read is not BinaryMarshalingVersion.
+func (evk *EvaluationKey) ReadFrom(r io.Reader) (n int64, err error) {
+	switch r := r.(type) {
+	case buffer.Reader:
+
+		var inc int64
+
+		if inc, err = readVersion(r); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		inc, err = evk.GadgetCiphertext.ReadFrom(r)
+
+		return n + inc, err
+
+	default:
+		return evk.ReadFrom(bufio.NewReader(r))
+	}
+}
+
+// MarshalBinary encodes the object into a binary form on a newly allocated slice of bytes.
+func (evk EvaluationKey) MarshalBinary() (p []byte, err error) {
+	buf := buffer.NewBufferSize(evk.BinarySize())
+	_, err = evk.WriteTo(buf)
+	return buf.Bytes(), err
+}
+
+// UnmarshalBinary decodes a slice of bytes generated by
+// MarshalBinary or WriteTo on the object.
+func (evk *EvaluationKey) UnmarshalBinary(p []byte) (err error) {
+	_, err = evk.ReadFrom(buffer.NewBuffer(p))
+	return
+}
+
 // RelinearizationKey is type of evaluation key used for ciphertext multiplication compactness.
 // The Relinearization key encrypts s^{2} under s and is used to homomorphically re-encrypt the
 // degree 2 term of a ciphertext (the term that decrypt with s^{2}) into a degree 1 term
@@ -420,7 +589,7 @@ func (gk GaloisKey) CopyNew() *GaloisKey {
 
 // BinarySize returns the serialized size of the object in bytes.
 func (gk GaloisKey) BinarySize() (size int) {
-	return gk.EvaluationKey.BinarySize() + 16
+	return 1 + gk.EvaluationKey.GadgetCiphertext.BinarySize() + 16
 }
 
 // WriteTo writes the object on an io.Writer. It implements the io.WriterTo
@@ -434,12 +603,20 @@ func (gk GaloisKey) BinarySize() (size int) {
 //     io.Writer in a pre-allocated bufio.Writer.
 //   - When writing to a pre-allocated var b []byte, it is preferable to pass
 //     buffer.NewBuffer(b) as w (see lattigo/utils/buffer/buffer.go).
+//
+// The first byte written is BinaryMarshalingVersion.
 func (gk GaloisKey) WriteTo(w io.Writer) (n int64, err error) {
 	switch w := w.(type) {
 	case buffer.Writer:
 
 		var inc int64
 
+		if inc, err = writeVersion(w); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
 		if inc, err = buffer.WriteUint64(w, gk.GaloisElement); err != nil {
 			return n + inc, err
 		}
@@ -452,7 +629,7 @@ func (gk GaloisKey) WriteTo(w io.Writer) (n int64, err error) {
 
 		n += inc
 
-		if inc, err = gk.EvaluationKey.WriteTo(w); err != nil {
+		if inc, err = gk.EvaluationKey.GadgetCiphertext.WriteTo(w); err != nil {
 			return n + inc, err
 		}
 
@@ -482,6 +659,12 @@ func (gk *GaloisKey) ReadFrom(r io.Reader) (n int64, err error) {
 
 		var inc int64
 
+		if inc, err = readVersion(r); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
 		if inc, err = buffer.ReadUint64(r, &gk.GaloisElement); err != nil {
 			return n + inc, err
 		}
@@ -494,7 +677,7 @@ func (gk *GaloisKey) ReadFrom(r io.Reader) (n int64, err error) {
 
 		n += inc
 
-		if inc, err = gk.EvaluationKey.ReadFrom(r); err != nil {
+		if inc, err = gk.EvaluationKey.GadgetCiphertext.ReadFrom(r); err != nil {
 			return n + inc, err
 		}
 
@@ -590,6 +773,82 @@ func (evk MemEvaluationKeySet) GetRelinearizationKey() (rk *RelinearizationKey,
 	return nil, fmt.Errorf("RelinearizationKey is nil")
 }
 
+// Merge adds to the receiver every GaloisKey of other that it does not already have, and, if the
+// receiver has no RelinearizationKey, adopts other's. It is meant for extending a key set that was
+// generated for a subset of the rotations (or without a RelinearizationKey) with additional keys
+// generated later, without discarding the keys already collected.
+//
+// Every key added is checked for parameter compatibility with the receiver's existing keys (or,
+// if the receiver is empty, with the first key merged in) by comparing LevelQ, LevelP and
+// BaseTwoDecomposition: Merge returns an error rather than silently merging keys that would be
+// unusable together.
+func (evk *MemEvaluationKeySet) Merge(other *MemEvaluationKeySet) (err error) {
+
+	if other == nil {
+		return nil
+	}
+
+	if evk.GaloisKeys == nil {
+		evk.GaloisKeys = map[uint64]*GaloisKey{}
+	}
+
+	var ref *EvaluationKey
+	if evk.RelinearizationKey != nil {
+		ref = &evk.RelinearizationKey.EvaluationKey
+	} else {
+		for _, gk := range evk.GaloisKeys {
+			ref = &gk.EvaluationKey
+			break
+		}
+	}
+
+	if ref == nil {
+		if other.RelinearizationKey != nil {
+			ref = &other.RelinearizationKey.EvaluationKey
+		} else {
+			for _, gk := range other.GaloisKeys {
+				ref = &gk.EvaluationKey
+				break
+			}
+		}
+	}
+
+	if ref != nil {
+		if other.RelinearizationKey != nil {
+			if err = checkEvaluationKeyFingerprint(ref, &other.RelinearizationKey.EvaluationKey); err != nil {
+				return fmt.Errorf("cannot Merge: RelinearizationKey: %w", err)
+			}
+		}
+		for galEl, gk := range other.GaloisKeys {
+			if err = checkEvaluationKeyFingerprint(ref, &gk.EvaluationKey); err != nil {
+				return fmt.Errorf("cannot Merge: GaloisKey[%d]: %w", galEl, err)
+			}
+		}
+	}
+
+	if evk.RelinearizationKey == nil {
+		evk.RelinearizationKey = other.RelinearizationKey
+	}
+
+	for galEl, gk := range other.GaloisKeys {
+		if _, ok := evk.GaloisKeys[galEl]; !ok {
+			evk.GaloisKeys[galEl] = gk
+		}
+	}
+
+	return nil
+}
+
+// checkEvaluationKeyFingerprint returns an error if a and b were not generated for compatible
+// gadget-ciphertext parameters.
+func checkEvaluationKeyFingerprint(a, b *EvaluationKey) error {
+	if a.LevelQ() != b.LevelQ() || a.LevelP() != b.LevelP() || a.BaseTwoDecomposition != b.BaseTwoDecomposition {
+		return fmt.Errorf("incompatible parameters: (LevelQ=%d, LevelP=%d, BaseTwoDecomposition=%d) != (LevelQ=%d, LevelP=%d, BaseTwoDecomposition=%d)",
+			a.LevelQ(), a.LevelP(), a.BaseTwoDecomposition, b.LevelQ(), b.LevelP(), b.BaseTwoDecomposition)
+	}
+	return nil
+}
+
 func (evk MemEvaluationKeySet) BinarySize() (size int) {
 
 	size++
@@ -733,6 +992,49 @@ func (evk *MemEvaluationKeySet) ReadFrom(r io.Reader) (n int64, err error) {
 	}
 }
 
+// WriteSubsetTo writes to w a MemEvaluationKeySet containing only the GaloisKeys whose Galois
+// element is in galEls (the RelinearizationKey and every other GaloisKey are omitted), using the
+// same encoding WriteTo would produce for a MemEvaluationKeySet built from just that subset. This
+// is meant for shipping to a remote computation only the rotation keys it actually uses, instead
+// of the full key set; pass params.GaloisElementForComplexConjugation() among galEls to include
+// conjugation, since it is itself a Galois element like any rotation.
+//
+// The result is loaded back with the regular ReadFrom or UnmarshalBinary, since it is a valid
+// encoding of a (smaller) MemEvaluationKeySet; no dedicated loader is needed.
+//
+// Returns an error if any element of galEls has no corresponding GaloisKey in the receiver.
+func (evk MemEvaluationKeySet) WriteSubsetTo(galEls []uint64, w io.Writer) (n int64, err error) {
+
+	subset := MemEvaluationKeySet{GaloisKeys: structs.Map[uint64, GaloisKey]{}}
+
+	for _, galEl := range galEls {
+		gk, err := evk.GetGaloisKey(galEl)
+		if err != nil {
+			return 0, fmt.Errorf("cannot WriteSubsetTo: %w", err)
+		}
+		subset.GaloisKeys[galEl] = gk
+	}
+
+	return subset.WriteTo(w)
+}
+
+// MarshalBinarySubset encodes, into a newly allocated slice of bytes, a MemEvaluationKeySet
+// containing only the GaloisKeys whose Galois element is in galEls. See WriteSubsetTo.
+func (evk MemEvaluationKeySet) MarshalBinarySubset(galEls []uint64) (p []byte, err error) {
+
+	subset := MemEvaluationKeySet{GaloisKeys: structs.Map[uint64, GaloisKey]{}}
+
+	for _, galEl := range galEls {
+		gk, err := evk.GetGaloisKey(galEl)
+		if err != nil {
+			return nil, fmt.Errorf("cannot MarshalBinarySubset: %w", err)
+		}
+		subset.GaloisKeys[galEl] = gk
+	}
+
+	return subset.MarshalBinary()
+}
+
 // MarshalBinary encodes the object into a binary form on a newly allocated slice of bytes.
 func (evk MemEvaluationKeySet) MarshalBinary() (p []byte, err error) {
 	buf := buffer.NewBufferSize(evk.BinarySize())
@@ -0,0 +1,33 @@
+package rlwe
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/utils/buffer"
+)
+
+// BinaryMarshalingVersion is the format version prepended to the serialization of Ciphertext,
+// SecretKey, PublicKey, EvaluationKey and GaloisKey (and, transitively, every type that embeds one
+// of them, such as RelinearizationKey). ReadFrom rejects a version it does not recognize with a
+// descriptive error instead of attempting to parse the remaining bytes as if they were the expected
+// layout, so that loading a blob produced by an incompatible version fails loudly rather than
+// silently corrupting the target object.
+const BinaryMarshalingVersion uint8 = 1
+
+// writeVersion writes BinaryMarshalingVersion on w.
+func writeVersion(w buffer.Writer) (n int64, err error) {
+	return buffer.WriteUint8(w, BinaryMarshalingVersion)
+}
+
+// readVersion reads a format version from r and returns an error if it does not match
+// BinaryMarshalingVersion.
+func readVersion(r buffer.Reader) (n int64, err error) {
+	var version uint8
+	if n, err = buffer.ReadUint8(r, &version); err != nil {
+		return n, err
+	}
+	if version != BinaryMarshalingVersion {
+		return n, fmt.Errorf("cannot ReadFrom: unsupported binary marshalling version %d (expected %d)", version, BinaryMarshalingVersion)
+	}
+	return n, nil
+}
@@ -2,10 +2,12 @@ package rlwe
 
 import (
 	"encoding/json"
+	"fmt"
 	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tuneinsight/lattigo/v5/ring"
 	"github.com/tuneinsight/lattigo/v5/utils"
 )
 
@@ -71,6 +73,21 @@ func benchKeyGenerator(tc *TestContext, bpw2 int, b *testing.B) {
 			kgen.GenEvaluationKey(sk0, sk1, evk)
 		}
 	})
+
+	galEls := append(params.GaloisElements([]int{1, 2, 4, 8, 16}), ring.GaloisGen)
+
+	b.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), bpw2, "KeyGenerator/GenAllKeysSequential"), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			kgen.GenRelinearizationKeyNew(tc.sk)
+			kgen.GenGaloisKeysNew(galEls, tc.sk)
+		}
+	})
+
+	b.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), bpw2, "KeyGenerator/GenAllKeysParallel"), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			kgen.GenAllKeysParallel(tc.sk, galEls, runtime.GOMAXPROCS(0))
+		}
+	})
 }
 
 func benchEncryptor(tc *TestContext, bpw2 int, b *testing.B) {
@@ -95,6 +112,31 @@ func benchEncryptor(tc *TestContext, bpw2 int, b *testing.B) {
 			enc.EncryptZero(ct)
 		}
 	})
+
+	const batchSize = 64
+
+	pts := make([]*Plaintext, batchSize)
+	for i := range pts {
+		pts[i] = NewPlaintext(params, params.MaxLevel())
+	}
+
+	b.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), bpw2, "Encryptor/EncryptNew/PublicKey/Loop"), func(b *testing.B) {
+		enc := tc.enc.WithKey(tc.pk)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, pt := range pts {
+				enc.EncryptNew(pt)
+			}
+		}
+	})
+
+	b.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), bpw2, "Encryptor/EncryptBatch/PublicKey"), func(b *testing.B) {
+		enc := tc.enc.WithKey(tc.pk)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			enc.EncryptBatch(pts)
+		}
+	})
 }
 
 func benchDecryptor(tc *TestContext, bpw2 int, b *testing.B) {
@@ -144,3 +186,52 @@ func benchEvaluator(tc *TestContext, bpw2 int, b *testing.B) {
 		})
 	}
 }
+
+// BenchmarkMarshalRotationKeys measures the marshalling and unmarshalling throughput of a full
+// power-of-two rotation key set (one GaloisKey per rotation step 1, 2, 4, ..., N/2, enough to
+// compose any rotation), for rings of increasing degree.
+func BenchmarkMarshalRotationKeys(b *testing.B) {
+
+	for _, logN := range []int{13, 14, 15} {
+
+		params, err := NewParametersFromLiteral(ParametersLiteral{
+			LogN:    logN,
+			LogQ:    []int{55, 55},
+			LogP:    []int{55},
+			NTTFlag: true,
+		})
+		require.NoError(b, err)
+
+		kgen := NewKeyGenerator(params)
+		sk := kgen.GenSecretKeyNew()
+
+		rotations := make([]int, params.LogN())
+		for i := range rotations {
+			rotations[i] = 1 << i
+		}
+
+		evk := NewMemEvaluationKeySet(nil, kgen.GenGaloisKeysNew(params.GaloisElements(rotations), sk)...)
+
+		var data []byte
+
+		b.Run(fmt.Sprintf("MarshalRotationKeys/logN=%d/NumKeys=%d", logN, len(rotations)), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if data, err = evk.MarshalBinary(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("UnmarshalRotationKeys/logN=%d/NumKeys=%d", logN, len(rotations)), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				unmarshalled := new(MemEvaluationKeySet)
+				if err := unmarshalled.UnmarshalBinary(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		runtime.GC()
+	}
+}
@@ -64,6 +64,7 @@ func TestRLWE(t *testing.T) {
 
 				testParameters(tc, t)
 				testKeyGenerator(tc, paramsLit.BaseTwoDecomposition, t)
+				testEvaluationKeySetMerge(tc, t)
 				testMarshaller(tc, t)
 				testWriteAndRead(tc, paramsLit.BaseTwoDecomposition, t)
 
@@ -71,7 +72,9 @@ func TestRLWE(t *testing.T) {
 
 					for _, testSet := range []func(tc *TestContext, level, bpw2 int, t *testing.T){
 						testEncryptor,
+						testDecryptor,
 						testGadgetProduct,
+						testGadgetCiphertextUnification,
 						testApplyEvaluationKey,
 						testAutomorphism,
 						testSlotOperations,
@@ -85,6 +88,8 @@ func TestRLWE(t *testing.T) {
 	}
 
 	testUserDefinedParameters(t)
+	testParameterSwitching(t)
+	testEvaluatorRingDegreeMismatch(t)
 }
 
 type TestContext struct {
@@ -239,6 +244,46 @@ func testKeyGenerator(tc *TestContext, bpw2 int, t *testing.T) {
 
 	})
 
+	// Checks that GenSecretKeyWithDistributionNew(ring.Ternary{H: hw}) produces a key of the
+	// requested Hamming weight, and that it decrypts what it encrypts.
+	t.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), bpw2, "KeyGenerator/GenSecretKeyWithDistribution"), func(t *testing.T) {
+
+		hw := params.XsHammingWeight()
+		if hw == 0 {
+			hw = params.N() >> 2
+		}
+
+		skSparse := kgen.GenSecretKeyWithDistributionNew(ring.Ternary{H: hw})
+
+		skSparseINTT := NewSecretKey(params)
+		params.RingQ().AtLevel(skSparse.LevelQ()).INTT(skSparse.Value.Q, skSparseINTT.Value.Q)
+		for i := range skSparseINTT.Value.Q.Coeffs {
+			var zeros int
+			for j := range skSparseINTT.Value.Q.Coeffs[i] {
+				if skSparseINTT.Value.Q.Coeffs[i][j] == 0 {
+					zeros++
+				}
+			}
+			require.Equal(t, params.ringQ.N(), zeros+hw)
+		}
+
+		encSparse := NewEncryptor(params, skSparse)
+		decSparse := NewDecryptor(params, skSparse)
+
+		pt := NewPlaintext(params, params.MaxLevel())
+		ct := NewCiphertext(params, 1, params.MaxLevel())
+
+		encSparse.Encrypt(pt, ct)
+		ptHave := decSparse.DecryptNew(ct)
+
+		ringQ := params.RingQ().AtLevel(params.MaxLevel())
+		if ptHave.IsNTT {
+			ringQ.INTT(ptHave.Value, ptHave.Value)
+		}
+
+		require.GreaterOrEqual(t, math.Log2(params.NoiseFreshSK())+1, ringQ.Log2OfStandardDeviation(ptHave.Value))
+	})
+
 	// Checks that sum([-as + e, a] + [as])) <= N * 6 * sigma
 	t.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), bpw2, "KeyGenerator/GenPublicKey"), func(t *testing.T) {
 
@@ -270,6 +315,30 @@ func testKeyGenerator(tc *TestContext, bpw2 int, t *testing.T) {
 		}
 	})
 
+	t.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), bpw2, "KeyGenerator/GenPublicKeyDeterministic"), func(t *testing.T) {
+
+		seed := []byte{0x1, 0x2, 0x3, 0x4}
+
+		pk0 := kgen.GenPublicKeyDeterministic(sk, seed)
+		pk1 := kgen.GenPublicKeyDeterministic(sk, seed)
+
+		require.True(t, pk0.Equal(pk1), "two calls with the same secret-key and seed should produce identical public keys")
+
+		ringQP := params.RingQP()
+
+		zero := ringQP.NewPoly()
+
+		ringQP.MulCoeffsMontgomery(sk.Value, pk0.Value[1], zero)
+		ringQP.Add(zero, pk0.Value[0], zero)
+		ringQP.INTT(zero, zero)
+		ringQP.IMForm(zero, zero)
+
+		require.GreaterOrEqual(t, math.Log2(params.NoiseFreshSK())+1, params.RingQ().Log2OfStandardDeviation(zero.Q))
+
+		pk2 := kgen.GenPublicKeyDeterministic(sk, []byte{0x5, 0x6, 0x7, 0x8})
+		require.False(t, pk0.Equal(pk2), "a different seed should produce a different public key")
+	})
+
 	var levelsQ = []int{0}
 	if params.MaxLevelQ() > 0 {
 		levelsQ = append(levelsQ, params.MaxLevelQ())
@@ -353,6 +422,22 @@ func testKeyGenerator(tc *TestContext, bpw2 int, t *testing.T) {
 			})
 		}
 	}
+
+	t.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), bpw2, "KeyGenerator/GenAllKeysParallel"), func(t *testing.T) {
+
+		galEls := append(params.GaloisElements([]int{1, 2, 4}), ring.GaloisGen)
+
+		evk := kgen.GenAllKeysParallel(sk, galEls, 4)
+
+		require.LessOrEqual(t, NoiseRelinearizationKey(evk.RelinearizationKey, sk, params), NoiseRelinearizationKey(kgen.GenRelinearizationKeyNew(sk), sk, params)+1)
+
+		for _, galEl := range galEls {
+			gk, err := evk.GetGaloisKey(galEl)
+			require.NoError(t, err)
+			require.Equal(t, galEl, gk.GaloisElement)
+			require.LessOrEqual(t, NoiseGaloisKey(gk, sk, params), NoiseGaloisKey(kgen.GenGaloisKeyNew(galEl, sk), sk, params)+1)
+		}
+	})
 }
 
 func testEncryptor(tc *TestContext, level, bpw2 int, t *testing.T) {
@@ -459,6 +544,85 @@ func testEncryptor(tc *TestContext, level, bpw2 int, t *testing.T) {
 		require.True(t, skEnc1.xsSampler == skEnc2.xsSampler)
 		require.True(t, skEnc1.xeSampler == skEnc2.xeSampler)
 	})
+
+	t.Run(testString(params, level, params.MaxLevelP(), bpw2, "Encryptor/EncryptPreprocessed"), func(t *testing.T) {
+
+		prng, err := sampling.NewPRNG()
+		require.NoError(t, err)
+
+		pt := NewPlaintext(params, level)
+		PopulateElementRandom(prng, params, pt.El())
+
+		skEnc := NewEncryptor(params, sk)
+
+		want, err := skEnc.EncryptNew(pt)
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			have, err := skEnc.EncryptPreprocessed(pt)
+			require.NoError(t, err)
+			require.Equal(t, want.MetaData, have.MetaData)
+			require.Equal(t, want.Level(), have.Level())
+		}
+
+		pt.IsNTT = !pt.IsNTT
+		_, err = skEnc.EncryptPreprocessed(pt)
+		require.Error(t, err)
+	})
+
+	t.Run(testString(params, level, params.MaxLevelP(), bpw2, "Encryptor/EncryptBatch"), func(t *testing.T) {
+
+		ringQ := params.RingQ().AtLevel(level)
+
+		pkEnc := enc.WithKey(pk)
+
+		pts := make([]*Plaintext, 4)
+		for i := range pts {
+			pts[i] = NewPlaintext(params, level)
+		}
+
+		cts, err := pkEnc.EncryptBatch(pts)
+		require.NoError(t, err)
+		require.Equal(t, len(pts), len(cts))
+
+		// Each ciphertext must decrypt to fresh noise around zero, and no two ciphertexts in the
+		// batch may end up sharing the same encryption-of-zero randomness.
+		for i, ct := range cts {
+			pt := NewPlaintext(params, level)
+			dec.Decrypt(ct, pt)
+
+			if pt.IsNTT {
+				ringQ.INTT(pt.Value, pt.Value)
+			}
+
+			require.GreaterOrEqual(t, math.Log2(params.NoiseFreshPK())+1, ringQ.Log2OfStandardDeviation(pt.Value))
+
+			for j := 0; j < i; j++ {
+				require.False(t, ringQ.Equal(ct.Value[1], cts[j].Value[1]))
+			}
+		}
+	})
+}
+
+func testDecryptor(tc *TestContext, level, bpw2 int, t *testing.T) {
+
+	params := tc.params
+	dec := tc.dec
+
+	t.Run(testString(params, level, params.MaxLevelP(), bpw2, "Decryptor/CanDecrypt"), func(t *testing.T) {
+
+		lastModulus := params.RingQ().SubRings[0].Modulus
+
+		ct := NewCiphertext(params, 1, level)
+
+		ct.Scale = NewScale(lastModulus * lastModulus)
+		require.True(t, dec.CanDecrypt(ct), "a ciphertext whose scale is well above the last modulus should be decryptable")
+
+		if level == 0 {
+			ct.Scale = NewScale(1)
+			require.False(t, dec.CanDecrypt(ct), "a level-0 ciphertext with a scale below the last modulus should not be decryptable")
+		}
+	})
 }
 
 func testGadgetProduct(tc *TestContext, levelQ, bpw2 int, t *testing.T) {
@@ -571,6 +735,179 @@ func testGadgetProduct(tc *TestContext, levelQ, bpw2 int, t *testing.T) {
 	}
 }
 
+// testGadgetCiphertextUnification checks that EvaluationKey, RelinearizationKey and GaloisKey are
+// genuinely interchangeable through their shared GadgetCiphertext: GadgetProduct only ever reads
+// the embedded GadgetCiphertext, so re-wrapping the same key material under a different key type
+// must not change GadgetProduct's output. EvaluationKey's MarshalBinary prepends a format version
+// byte that the bare GadgetCiphertext's does not carry, so once that byte is stripped the two must
+// still be byte-identical. It also checks that GaloisKey.MarshalBinary, which has its own fields on
+// top of EvaluationKey, carries exactly one version byte, and that it round-trips correctly.
+func testGadgetCiphertextUnification(tc *TestContext, levelQ, bpw2 int, t *testing.T) {
+
+	params := tc.params
+	sk := tc.sk
+	kgen := tc.kgen
+	eval := tc.eval
+
+	t.Run(testString(params, levelQ, params.MaxLevelP(), bpw2, "GadgetCiphertext/Unification"), func(t *testing.T) {
+
+		evkParams := EvaluationKeyParameters{LevelQ: utils.Pointy(levelQ), LevelP: utils.Pointy(params.MaxLevelP()), BaseTwoDecomposition: utils.Pointy(bpw2)}
+
+		rlk := kgen.GenRelinearizationKeyNew(sk, evkParams)
+		gk := kgen.GenGaloisKeyNew(5, sk, evkParams)
+
+		// RelinearizationKey and GaloisKey both wrap an EvaluationKey which itself wraps a
+		// GadgetCiphertext: since neither RelinearizationKey nor EvaluationKey add any field of
+		// their own beyond EvaluationKey's version byte, each must marshal to exactly the same
+		// bytes as the GadgetCiphertext it embeds, save for that leading byte.
+		gcData, err := rlk.GadgetCiphertext.MarshalBinary()
+		require.NoError(t, err)
+		evkData, err := rlk.EvaluationKey.MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, gcData, evkData[1:])
+
+		gkGcData, err := gk.GadgetCiphertext.MarshalBinary()
+		require.NoError(t, err)
+		gkEvkData, err := gk.EvaluationKey.MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, gkGcData, gkEvkData[1:])
+
+		// gk.MarshalBinary itself must carry exactly one version byte, not one of its own plus
+		// another nested one from re-invoking the versioned EvaluationKey.WriteTo: after the
+		// version byte and the GaloisElement/NthRoot fields, the remaining bytes must be the bare
+		// GadgetCiphertext encoding, with no extra version byte in between.
+		gkData, err := gk.MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, gkGcData, gkData[1+8+8:])
+
+		gkBack := new(GaloisKey)
+		require.NoError(t, gkBack.UnmarshalBinary(gkData))
+		require.True(t, gk.Equal(gkBack))
+
+		// GadgetProduct only ever reads the embedded GadgetCiphertext, so evaluating it through the
+		// RelinearizationKey's GadgetCiphertext must behave identically to evaluating it through a
+		// plain EvaluationKey built from the exact same GadgetCiphertext.
+		prng, err := sampling.NewPRNG()
+		require.NoError(t, err)
+
+		a := ring.NewUniformSampler(prng, params.RingQ().AtLevel(levelQ)).ReadNew()
+
+		evk := &EvaluationKey{GadgetCiphertext: rlk.GadgetCiphertext}
+
+		ctFromRlk := NewCiphertext(params, 1, levelQ)
+		eval.GadgetProduct(levelQ, a, &rlk.GadgetCiphertext, ctFromRlk)
+
+		ctFromEvk := NewCiphertext(params, 1, levelQ)
+		eval.GadgetProduct(levelQ, a, &evk.GadgetCiphertext, ctFromEvk)
+
+		require.True(t, ctFromRlk.Equal(ctFromEvk))
+	})
+}
+
+// testParameterSwitching checks that GenParameterSwitchingKeyNew rejects Parameters of mismatching
+// ring degree, and that the EvaluationKey it returns for matching ring degrees correctly moves a
+// Ciphertext from a Parameters with more levels to one with fewer, decrypting correctly under the
+// target secret-key.
+func testParameterSwitching(t *testing.T) {
+	t.Run("KeyGenerator/GenParameterSwitchingKeyNew", func(t *testing.T) {
+
+		paramsSource, err := NewParametersFromLiteral(ParametersLiteral{
+			LogN:    logN,
+			Q:       qi,
+			P:       pj,
+			NTTFlag: true,
+		})
+		require.NoError(t, err)
+
+		paramsTarget, err := NewParametersFromLiteral(ParametersLiteral{
+			LogN:    logN,
+			Q:       qi[:2],
+			P:       pj,
+			NTTFlag: true,
+		})
+		require.NoError(t, err)
+
+		kgenSource := NewKeyGenerator(paramsSource)
+		skSource := kgenSource.GenSecretKeyNew()
+
+		skTarget := NewKeyGenerator(paramsTarget).GenSecretKeyNew()
+
+		paramsOtherN, err := NewParametersFromLiteral(ParametersLiteral{
+			LogN:    logN - 1,
+			Q:       qi[:2],
+			NTTFlag: true,
+		})
+		require.NoError(t, err)
+
+		_, err = kgenSource.GenParameterSwitchingKeyNew(skSource, paramsOtherN, skTarget)
+		require.Error(t, err)
+
+		evk, err := kgenSource.GenParameterSwitchingKeyNew(skSource, paramsTarget, skTarget)
+		require.NoError(t, err)
+
+		levelSource := paramsSource.MaxLevel()
+		levelTarget := paramsTarget.MaxLevel()
+
+		pt := NewPlaintext(paramsSource, levelSource)
+		ct := NewCiphertext(paramsSource, 1, levelSource)
+		NewEncryptor(paramsSource, skSource).Encrypt(pt, ct)
+
+		ctOut := NewCiphertext(paramsTarget, 1, levelTarget)
+		require.NoError(t, NewEvaluator(paramsSource, nil).ApplyEvaluationKey(ct, evk, ctOut))
+
+		ptOut := NewPlaintext(paramsTarget, levelTarget)
+		NewDecryptor(paramsTarget, skTarget).Decrypt(ctOut, ptOut)
+
+		ringQ := paramsTarget.RingQ().AtLevel(levelTarget)
+
+		if ptOut.IsNTT {
+			ringQ.INTT(ptOut.Value, ptOut.Value)
+		}
+
+		require.GreaterOrEqual(t, float64(paramsTarget.LogN()), ringQ.Log2OfStandardDeviation(ptOut.Value))
+	})
+}
+
+func testEvaluatorRingDegreeMismatch(t *testing.T) {
+	t.Run("Evaluator/RingDegreeMismatch", func(t *testing.T) {
+
+		params, err := NewParametersFromLiteral(ParametersLiteral{
+			LogN:    logN,
+			Q:       qi,
+			P:       pj,
+			NTTFlag: true,
+		})
+		require.NoError(t, err)
+
+		paramsOtherN, err := NewParametersFromLiteral(ParametersLiteral{
+			LogN:    logN - 1,
+			Q:       qi[:2],
+			NTTFlag: true,
+		})
+		require.NoError(t, err)
+
+		sk := NewKeyGenerator(params).GenSecretKeyNew()
+		skOtherN := NewKeyGenerator(paramsOtherN).GenSecretKeyNew()
+
+		ct := NewCiphertext(params, 1, params.MaxLevel())
+		NewEncryptor(params, sk).Encrypt(NewPlaintext(params, params.MaxLevel()), ct)
+
+		ctOtherN := NewCiphertext(paramsOtherN, 1, paramsOtherN.MaxLevel())
+		NewEncryptor(paramsOtherN, skOtherN).Encrypt(NewPlaintext(paramsOtherN, paramsOtherN.MaxLevel()), ctOtherN)
+
+		// Mixing a ciphertext encrypted under paramsOtherN into an Evaluator instantiated for params
+		// must be reported as a clear error, instead of panicking or silently producing garbage deep
+		// inside the ring package.
+		eval := NewEvaluator(params, nil)
+
+		_, _, err = eval.InitOutputBinaryOp(ct.El(), ctOtherN.El(), 2, ct.El())
+		require.Error(t, err)
+
+		_, _, err = eval.InitOutputUnaryOp(ctOtherN.El(), ctOtherN.El())
+		require.Error(t, err)
+	})
+}
+
 func testApplyEvaluationKey(tc *TestContext, level, bpw2 int, t *testing.T) {
 
 	params := tc.params
@@ -747,6 +1084,62 @@ func testAutomorphism(tc *TestContext, level, bpw2 int, t *testing.T) {
 		require.GreaterOrEqual(t, NoiseBound, ringQ.Log2OfStandardDeviation(pt.Value))
 	})
 
+	t.Run(testString(params, level, params.MaxLevelP(), bpw2, "Evaluator/Automorphism/NoiseFlooding"), func(t *testing.T) {
+
+		// Flooding noise well above the base key-switching noise, so that it dominates the
+		// measured output noise, but kept well below the smallest modulus in the chain so
+		// that the sampled coefficients do not wrap around it.
+		floodingSigma := math.Exp2(30)
+
+		noiseFlooding := ring.DiscreteGaussian{Sigma: floodingSigma, Bound: 6 * floodingSigma}
+
+		evalFlooding, err := eval.WithNoiseFlooding(noiseFlooding)
+		require.NoError(t, err)
+
+		// Generate a plaintext with values up to 2^30
+		pt := genPlaintext(params, level, 1<<30)
+
+		// Encrypt
+		ct, err := enc.EncryptNew(pt)
+		require.NoError(t, err)
+
+		// Chooses a Galois Element (must be coprime with 2N)
+		galEl := params.GaloisElement(-1)
+
+		// Allocate a new EvaluationKeySet and adds the GaloisKey
+		evk := NewMemEvaluationKeySet(nil, kgen.GenGaloisKeyNew(galEl, sk, evkParams))
+
+		// Evaluate the automorphism with the flooding evaluator
+		require.NoError(t, evalFlooding.WithKey(evk).Automorphism(ct, galEl, ct))
+
+		// Apply the same automorphism on the plaintext
+		ringQ := params.RingQ().AtLevel(level)
+
+		tmp := ringQ.NewPoly()
+		if pt.IsNTT {
+			ringQ.AutomorphismNTT(pt.Value, galEl, tmp)
+		} else {
+			ringQ.Automorphism(pt.Value, galEl, tmp)
+		}
+
+		// Decrypt
+		dec.Decrypt(ct, pt)
+
+		// Subract the permuted plaintext to the decrypted plaintext
+		ringQ.Sub(pt.Value, tmp, pt.Value)
+
+		// Switch out of NTT if required
+		if pt.IsNTT {
+			ringQ.INTT(pt.Value, pt.Value)
+		}
+
+		// The result must still decrypt, but with noise now dominated by the flooding,
+		// i.e. well above the base key-switching noise bound and close to floodingSigma.
+		noise := ringQ.Log2OfStandardDeviation(pt.Value)
+		require.Greater(t, noise, NoiseBound)
+		require.InDelta(t, math.Log2(floodingSigma), noise, 3)
+	})
+
 	t.Run(testString(params, level, params.MaxLevelP(), bpw2, "Evaluator/AutomorphismHoisted"), func(t *testing.T) {
 
 		if bpw2 != 0 {
@@ -1059,6 +1452,26 @@ func testSlotOperations(tc *TestContext, level, bpw2 int, t *testing.T) {
 		require.GreaterOrEqual(t, NoiseBound, ringQ.Log2OfStandardDeviation(pt.Value))
 	})
 
+	t.Run(testString(params, level, params.MaxLevelP(), bpw2, "GaloisElementsForInnerSum/KeyCount"), func(t *testing.T) {
+
+		// The number of distinct Galois elements InnerSum needs is at most 2*ceil(log2(n)), the
+		// baby-step/giant-step pair for each power of two below n, deduplicated; these counts
+		// document that bound for a handful of representative n, including powers of two (where
+		// every giant step equals n, so they collapse into a single element).
+		for _, tv := range []struct{ n, wantCount int }{
+			{1, 0},
+			{2, 2},
+			{4, 3},
+			{5, 4},
+			{7, 5},
+			{8, 4},
+			{16, 5},
+			{100, 10},
+		} {
+			require.Equal(t, tv.wantCount, len(GaloisElementsForInnerSum(params, 1, tv.n)), "n=%d", tv.n)
+		}
+	})
+
 	t.Run(testString(params, level, params.MaxLevelP(), bpw2, "Evaluator/InnerSum"), func(t *testing.T) {
 
 		if params.MaxLevelP() == -1 {
@@ -1105,6 +1518,71 @@ func testSlotOperations(tc *TestContext, level, bpw2 int, t *testing.T) {
 		require.GreaterOrEqual(t, NoiseBound, ringQ.Log2OfStandardDeviation(pt.Value))
 
 	})
+
+	t.Run(testString(params, level, params.MaxLevelP(), bpw2, "Evaluator/InnerSum2DGrid"), func(t *testing.T) {
+
+		if params.MaxLevelP() == -1 {
+			t.Skip("test requires #P > 0")
+		}
+
+		rows := 4
+		cols := 8
+
+		ringQ := tc.params.RingQ().AtLevel(level)
+
+		pt := genPlaintext(params, level, 1<<30)
+		ptRef := *pt.Value.CopyNew()
+		ct, err := enc.EncryptNew(pt)
+		require.NoError(t, err)
+
+		// Galois keys for rows (stride 1) and columns (stride cols) only.
+		evk := NewMemEvaluationKeySet(nil, kgen.GenGaloisKeysNew(GaloisElementsFor2DGrid(params, rows, cols), sk)...)
+		evalWithKey := eval.WithKey(evk)
+
+		ctRows := ct.CopyNew()
+		require.NoError(t, evalWithKey.InnerSum(ctRows, 1, cols, ctRows))
+
+		ctCols := ct.CopyNew()
+		require.NoError(t, evalWithKey.InnerSum(ctCols, cols, rows, ctCols))
+
+		if pt.IsNTT {
+			ringQ.INTT(ptRef, ptRef)
+		}
+
+		polyTmp := ringQ.NewPoly()
+
+		// Row-wise inner sum (naive) on the plaintext.
+		ptRows := *ptRef.CopyNew()
+		for i := 1; i < cols; i++ {
+			galEl := params.GaloisElement(i)
+			ringQ.Automorphism(ptRef, galEl, polyTmp)
+			ringQ.Add(ptRows, polyTmp, ptRows)
+		}
+
+		// Column-wise inner sum (naive) on the plaintext.
+		ptCols := *ptRef.CopyNew()
+		for i := 1; i < rows; i++ {
+			galEl := params.GaloisElement(i * cols)
+			ringQ.Automorphism(ptRef, galEl, polyTmp)
+			ringQ.Add(ptCols, polyTmp, ptCols)
+		}
+
+		NoiseBound := float64(params.LogN())
+
+		dec.Decrypt(ctRows, pt)
+		if pt.IsNTT {
+			ringQ.INTT(pt.Value, pt.Value)
+		}
+		ringQ.Sub(pt.Value, ptRows, pt.Value)
+		require.GreaterOrEqual(t, NoiseBound, ringQ.Log2OfStandardDeviation(pt.Value))
+
+		dec.Decrypt(ctCols, pt)
+		if pt.IsNTT {
+			ringQ.INTT(pt.Value, pt.Value)
+		}
+		ringQ.Sub(pt.Value, ptCols, pt.Value)
+		require.GreaterOrEqual(t, NoiseBound, ringQ.Log2OfStandardDeviation(pt.Value))
+	})
 }
 
 func genPlaintext(params Parameters, level, max int) (pt *Plaintext) {
@@ -1196,6 +1674,22 @@ func testWriteAndRead(tc *TestContext, bpw2 int, t *testing.T) {
 		}
 	})
 
+	t.Run(testString(params, levelQ, levelP, bpw2, "WriteAndRead/UnsupportedVersion"), func(t *testing.T) {
+
+		prng, _ := sampling.NewPRNG()
+
+		ct := NewCiphertextRandom(prng, params, 1, levelQ)
+
+		data, err := ct.MarshalBinary()
+		require.NoError(t, err)
+
+		data[0] = BinaryMarshalingVersion + 1
+
+		err = NewCiphertext(params, 1, levelQ).UnmarshalBinary(data)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported binary marshalling version")
+	})
+
 	t.Run(testString(params, levelQ, levelP, bpw2, "WriteAndRead/GadgetCiphertext"), func(t *testing.T) {
 
 		rlk := NewRelinearizationKey(params, EvaluationKeyParameters{BaseTwoDecomposition: utils.Pointy(bpw2)})
@@ -1232,6 +1726,125 @@ func testWriteAndRead(tc *TestContext, bpw2 int, t *testing.T) {
 			GaloisKeys:         map[uint64]*GaloisKey{galEl: tc.kgen.GenGaloisKeyNew(galEl, tc.sk)},
 		})
 	})
+
+	t.Run(testString(params, levelQ, levelP, bpw2, "WriteAndRead/FullRotationKeySet"), func(t *testing.T) {
+
+		rotations := make([]int, params.LogN())
+		for i := range rotations {
+			rotations[i] = 1 << i
+		}
+
+		evkWant := NewMemEvaluationKeySet(tc.kgen.GenRelinearizationKeyNew(tc.sk), tc.kgen.GenGaloisKeysNew(params.GaloisElements(rotations), tc.sk)...)
+
+		buffer.RequireSerializerCorrect(t, evkWant)
+
+		data, err := evkWant.MarshalBinary()
+		require.NoError(t, err)
+
+		evkGot := new(MemEvaluationKeySet)
+		require.NoError(t, evkGot.UnmarshalBinary(data))
+
+		require.Equal(t, len(rotations), len(evkGot.GetGaloisKeysList()))
+
+		for _, galEl := range params.GaloisElements(rotations) {
+			gkWant, err := evkWant.GetGaloisKey(galEl)
+			require.NoError(t, err)
+			gkGot, err := evkGot.GetGaloisKey(galEl)
+			require.NoError(t, err)
+			require.True(t, gkWant.Equal(gkGot))
+		}
+	})
+
+	t.Run(testString(params, levelQ, levelP, bpw2, "WriteAndRead/EvaluationKeySetSubset"), func(t *testing.T) {
+
+		rotations := []int{1, 2, 4}
+		galEls := params.GaloisElements(rotations)
+
+		evkFull := NewMemEvaluationKeySet(tc.kgen.GenRelinearizationKeyNew(tc.sk), tc.kgen.GenGaloisKeysNew(galEls, tc.sk)...)
+
+		data, err := evkFull.MarshalBinarySubset(galEls[:2])
+		require.NoError(t, err)
+
+		evkSubset := new(MemEvaluationKeySet)
+		require.NoError(t, evkSubset.UnmarshalBinary(data))
+
+		// Only the requested rotations are present, and no RelinearizationKey.
+		require.Equal(t, 2, len(evkSubset.GetGaloisKeysList()))
+		_, err = evkSubset.GetRelinearizationKey()
+		require.Error(t, err)
+
+		for _, galEl := range galEls[:2] {
+			gkWant, err := evkFull.GetGaloisKey(galEl)
+			require.NoError(t, err)
+			gkGot, err := evkSubset.GetGaloisKey(galEl)
+			require.NoError(t, err)
+			require.True(t, gkWant.Equal(gkGot))
+		}
+
+		_, err = evkSubset.GetGaloisKey(galEls[2])
+		require.Error(t, err)
+
+		// Requesting a Galois element absent from the source set must fail.
+		_, err = evkFull.MarshalBinarySubset(params.GaloisElements([]int{8}))
+		require.Error(t, err)
+	})
+}
+
+func testEvaluationKeySetMerge(tc *TestContext, t *testing.T) {
+
+	params := tc.params
+	kgen := tc.kgen
+	sk := tc.sk
+
+	t.Run("EvaluationKeySet/Merge", func(t *testing.T) {
+
+		rotations := params.GaloisElements([]int{1, 2})
+		a := NewMemEvaluationKeySet(kgen.GenRelinearizationKeyNew(sk), kgen.GenGaloisKeysNew(rotations[:1], sk)...)
+		b := NewMemEvaluationKeySet(nil, kgen.GenGaloisKeysNew(rotations[1:], sk)...)
+
+		require.NoError(t, a.Merge(b))
+
+		// a keeps its own RelinearizationKey and gains b's GaloisKey without losing its own.
+		_, err := a.GetRelinearizationKey()
+		require.NoError(t, err)
+
+		for _, galEl := range rotations {
+			_, err := a.GetGaloisKey(galEl)
+			require.NoError(t, err)
+		}
+
+		// Merging keys generated for incompatible gadget-ciphertext parameters must fail.
+		incompatible := NewMemEvaluationKeySet(nil, kgen.GenGaloisKeysNew(params.GaloisElements([]int{3}), sk, EvaluationKeyParameters{LevelQ: utils.Pointy(params.MaxLevelQ() - 1)})...)
+		require.Error(t, a.Merge(incompatible))
+	})
+
+	t.Run("EvaluationKeySet/Merge/Subset", func(t *testing.T) {
+
+		// A distributed setup: base holds a relinearization key and a few rotations, and receives
+		// an incremental set of additional rotations sent as a MarshalBinarySubset of a larger,
+		// locally generated key set, without ever shipping the RelinearizationKey or the rotations
+		// base already has.
+		rotations := params.GaloisElements([]int{1, 2, 4, 8})
+
+		base := NewMemEvaluationKeySet(kgen.GenRelinearizationKeyNew(sk), kgen.GenGaloisKeysNew(rotations[:2], sk)...)
+
+		full := NewMemEvaluationKeySet(nil, kgen.GenGaloisKeysNew(rotations, sk)...)
+		data, err := full.MarshalBinarySubset(rotations[2:])
+		require.NoError(t, err)
+
+		incremental := new(MemEvaluationKeySet)
+		require.NoError(t, incremental.UnmarshalBinary(data))
+
+		require.NoError(t, base.Merge(incremental))
+
+		_, err = base.GetRelinearizationKey()
+		require.NoError(t, err)
+
+		for _, galEl := range rotations {
+			_, err := base.GetGaloisKey(galEl)
+			require.NoError(t, err)
+		}
+	})
 }
 
 func testMarshaller(tc *TestContext, t *testing.T) {
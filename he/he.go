@@ -12,6 +12,20 @@ type Encoder[T any, U *ring.Poly | ringqp.Poly | *rlwe.Plaintext] interface {
 	Encode(values []T, metaData *rlwe.MetaData, output U) (err error)
 }
 
+// Decoder defines a set of common and scheme agnostic method provided by an Encoder struct,
+// symmetric to Encoder: it recovers the values that were passed to Encode from the plaintext-
+// domain object and MetaData that Encode produced.
+//
+// The scheme-specific Encoder types (ckks.Encoder, bgv.Encoder, bfv.Encoder) expose richer,
+// scheme-specific Decode methods taking a *rlwe.Plaintext and a scheme-specific slice type (see
+// their package documentation) rather than this exact generic signature, so satisfying Decoder
+// generally requires a small adapter rather than being implemented directly by those types; this
+// interface exists for writing scheme-agnostic generic helpers against such an adapter, the same
+// way Encoder is used today.
+type Decoder[T any, U *ring.Poly | ringqp.Poly | *rlwe.Plaintext] interface {
+	Decode(input U, metaData *rlwe.MetaData) (values []T, err error)
+}
+
 // Evaluator defines a set of common and scheme agnostic method provided by an Evaluator struct.
 type Evaluator interface {
 	rlwe.ParameterProvider
@@ -28,3 +42,13 @@ type Evaluator interface {
 	Rescale(op0, op1 *rlwe.Ciphertext) (err error)
 	GetEvaluatorBuffer() *rlwe.EvaluatorBuffers // TODO extract
 }
+
+// EvaluatorWithRotations extends Evaluator with column rotations, for circuits that need them in
+// addition to the arithmetic operations common to every scheme. CKKS exposes this operation as
+// Rotate, while BGV and BFV expose it as RotateColumns; RotateColumns is the name this interface
+// settles on, and ckks.Evaluator is given a thin RotateColumns adapter forwarding to Rotate so that
+// it, too, satisfies this interface.
+type EvaluatorWithRotations interface {
+	Evaluator
+	RotateColumns(op0 *rlwe.Ciphertext, k int, opOut *rlwe.Ciphertext) (err error)
+}
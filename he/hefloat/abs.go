@@ -0,0 +1,207 @@
+package hefloat
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+)
+
+// AbsMethod selects the homomorphic method used by ComparisonEvaluator.Abs to evaluate |x|.
+type AbsMethod int
+
+const (
+	// AbsSign evaluates |x| as x * Sign(x), reusing the evaluator's composite sign polynomial.
+	// Its accuracy inherits that of Sign: inside the sign polynomial's transition band, i.e. for
+	// |x| below the distinguishable delta 2^{-alpha} that MinimaxCompositeSignPolynomial was
+	// generated for, Sign(x) interpolates smoothly between -1 and 1 instead of snapping to a
+	// discrete value, so |x| is underestimated close to 0 rather than being discontinuous there.
+	AbsSign AbsMethod = iota
+
+	// AbsSqrt evaluates |x| as sqrt(x^2), computed by running a Newton-Raphson iteration for
+	// 1/sqrt(x^2) seeded at y0 = 1 and then multiplying the result back by x^2. y0 = 1 is the
+	// right order of magnitude for |x| close to 1 but is a poor seed for small |x|, for which
+	// 1/sqrt(x^2) is large: the iteration needs roughly log2(1/|x|) rounds to converge there, so
+	// with few iterations |x| is increasingly underestimated as |x| approaches 0 (e.g. it can
+	// read many times too small rather than too large). Use more iterations, or prefer AbsSign,
+	// when small inputs near zero must be resolved accurately.
+	AbsSqrt
+)
+
+// Abs homomorphically evaluates the absolute value of op0, using the method specified by
+// method. op0 must have values in the interval [-1, 1].
+//
+// For method == AbsSign, this method ensures that abs.Scale = params.DefaultScale().
+// For method == AbsSqrt, iterations controls the number of Newton-Raphson iterations used to
+// approximate 1/sqrt(x^2) and is otherwise unused; it must be strictly positive.
+//
+// See the documentation of AbsSign and AbsSqrt for the accuracy of each method near zero.
+func (eval ComparisonEvaluator) Abs(op0 *rlwe.Ciphertext, method AbsMethod, iterations int) (abs *rlwe.Ciphertext, err error) {
+	switch method {
+	case AbsSign:
+		return eval.absSign(op0)
+	case AbsSqrt:
+		if iterations < 1 {
+			return nil, fmt.Errorf("cannot Abs: iterations must be > 0 but is %d", iterations)
+		}
+		return eval.absSqrt(op0, iterations)
+	default:
+		return nil, fmt.Errorf("cannot Abs: invalid AbsMethod: %d", method)
+	}
+}
+
+// absSign computes |x| = x * Sign(x).
+func (eval ComparisonEvaluator) absSign(op0 *rlwe.Ciphertext) (abs *rlwe.Ciphertext, err error) {
+
+	params := eval.Parameters
+	btp := eval.Bootstrapper
+
+	var sign *rlwe.Ciphertext
+	if sign, err = eval.Sign(op0); err != nil {
+		return nil, fmt.Errorf("cannot Abs: %w", err)
+	}
+
+	abs = op0.CopyNew()
+
+	levelsPerRescaling := params.LevelsConsumedPerRescaling()
+
+	if abs.Level() < levelsPerRescaling {
+		if abs, err = btp.Bootstrap(abs); err != nil {
+			return nil, fmt.Errorf("cannot Abs: %w", err)
+		}
+	}
+
+	if sign.Level() < levelsPerRescaling {
+		if sign, err = btp.Bootstrap(sign); err != nil {
+			return nil, fmt.Errorf("cannot Abs: %w", err)
+		}
+	}
+
+	if err = eval.MulRelin(abs, sign, abs); err != nil {
+		return nil, fmt.Errorf("cannot Abs: %w", err)
+	}
+
+	if err = eval.Rescale(abs, abs); err != nil {
+		return nil, fmt.Errorf("cannot Abs: %w", err)
+	}
+
+	return abs, nil
+}
+
+// absSqrt computes |x| = sqrt(x^2) by running the Newton-Raphson iteration for y = 1/sqrt(z),
+// z = x^2:
+//
+//	y_{n+1} = y_n * (1.5 - 0.5 * z * y_n^2)
+//
+// seeded at y0 = 1, for which the first iteration reduces to the affine map y1 = 1.5 - 0.5*z and
+// can be computed without a ciphertext-ciphertext multiplication. |x| is then recovered as z * y,
+// after the requested number of iterations.
+func (eval ComparisonEvaluator) absSqrt(op0 *rlwe.Ciphertext, iterations int) (abs *rlwe.Ciphertext, err error) {
+
+	params := eval.Parameters
+	btp := eval.Bootstrapper
+
+	levelsPerRescaling := params.LevelsConsumedPerRescaling()
+
+	bootstrapIfNeeded := func(ct *rlwe.Ciphertext, levelsNeeded int) (*rlwe.Ciphertext, error) {
+		if ct.Level() < levelsNeeded {
+			return btp.Bootstrap(ct)
+		}
+		return ct, nil
+	}
+
+	// z = x^2
+	z := op0.CopyNew()
+	if z, err = bootstrapIfNeeded(z, levelsPerRescaling); err != nil {
+		return nil, fmt.Errorf("cannot Abs: %w", err)
+	}
+	if err = eval.MulRelin(z, z, z); err != nil {
+		return nil, fmt.Errorf("cannot Abs: %w", err)
+	}
+	if err = eval.Rescale(z, z); err != nil {
+		return nil, fmt.Errorf("cannot Abs: %w", err)
+	}
+
+	// y1 = 1.5 - 0.5*z (y0 = 1)
+	var y *rlwe.Ciphertext
+	if y, err = bootstrapIfNeeded(z.CopyNew(), levelsPerRescaling); err != nil {
+		return nil, fmt.Errorf("cannot Abs: %w", err)
+	}
+	if err = eval.Mul(y, -0.5, y); err != nil {
+		return nil, fmt.Errorf("cannot Abs: %w", err)
+	}
+	if err = eval.Rescale(y, y); err != nil {
+		return nil, fmt.Errorf("cannot Abs: %w", err)
+	}
+	if err = eval.Add(y, 1.5, y); err != nil {
+		return nil, fmt.Errorf("cannot Abs: %w", err)
+	}
+
+	for i := 1; i < iterations; i++ {
+
+		if z, err = bootstrapIfNeeded(z, 2*levelsPerRescaling); err != nil {
+			return nil, fmt.Errorf("cannot Abs: %w", err)
+		}
+		if y, err = bootstrapIfNeeded(y, 2*levelsPerRescaling); err != nil {
+			return nil, fmt.Errorf("cannot Abs: %w", err)
+		}
+
+		// t = z*y^2
+		var y2, t *rlwe.Ciphertext
+		if y2, err = eval.MulRelinNew(y, y); err != nil {
+			return nil, fmt.Errorf("cannot Abs: %w", err)
+		}
+		if err = eval.Rescale(y2, y2); err != nil {
+			return nil, fmt.Errorf("cannot Abs: %w", err)
+		}
+
+		if t, err = eval.MulRelinNew(z, y2); err != nil {
+			return nil, fmt.Errorf("cannot Abs: %w", err)
+		}
+		if err = eval.Rescale(t, t); err != nil {
+			return nil, fmt.Errorf("cannot Abs: %w", err)
+		}
+
+		// r = 1.5 - 0.5*t
+		if err = eval.Mul(t, -0.5, t); err != nil {
+			return nil, fmt.Errorf("cannot Abs: %w", err)
+		}
+		if err = eval.Rescale(t, t); err != nil {
+			return nil, fmt.Errorf("cannot Abs: %w", err)
+		}
+		if err = eval.Add(t, 1.5, t); err != nil {
+			return nil, fmt.Errorf("cannot Abs: %w", err)
+		}
+
+		// y = y*r
+		if y.Level() < levelsPerRescaling || t.Level() < levelsPerRescaling {
+			if y, err = btp.Bootstrap(y); err != nil {
+				return nil, fmt.Errorf("cannot Abs: %w", err)
+			}
+			if t, err = btp.Bootstrap(t); err != nil {
+				return nil, fmt.Errorf("cannot Abs: %w", err)
+			}
+		}
+		if err = eval.MulRelin(y, t, y); err != nil {
+			return nil, fmt.Errorf("cannot Abs: %w", err)
+		}
+		if err = eval.Rescale(y, y); err != nil {
+			return nil, fmt.Errorf("cannot Abs: %w", err)
+		}
+	}
+
+	// |x| = z*y
+	if z, err = bootstrapIfNeeded(z, levelsPerRescaling); err != nil {
+		return nil, fmt.Errorf("cannot Abs: %w", err)
+	}
+	if y, err = bootstrapIfNeeded(y, levelsPerRescaling); err != nil {
+		return nil, fmt.Errorf("cannot Abs: %w", err)
+	}
+	if err = eval.MulRelin(z, y, z); err != nil {
+		return nil, fmt.Errorf("cannot Abs: %w", err)
+	}
+	if err = eval.Rescale(z, z); err != nil {
+		return nil, fmt.Errorf("cannot Abs: %w", err)
+	}
+
+	return z, nil
+}
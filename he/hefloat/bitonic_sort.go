@@ -0,0 +1,157 @@
+package hefloat
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+)
+
+// BitonicSort sorts, in ascending order, the values encoded in the first n slots of ct, using a
+// bitonic sorting network built out of rotations and ComparisonEvaluator.CompareAndSwap. n must be
+// a power of two and at most ct.Slots(). The content of slots [n, ct.Slots()) is undefined in the
+// result.
+//
+// rotEval is used for the rotations and the plaintext masking that the network needs in addition to
+// the comparisons provided by the receiver; it must have been instantiated with the rlwe.GaloisKeys
+// for every power-of-two rotation in [1, n/2] and their negations, e.g. via
+// params.GaloisElementsForInnerSum(1, n) together with the Galois elements for the corresponding
+// negative rotations, generated with rlwe.KeyGenerator.GenGaloisKeysNew.
+//
+// A bitonic network on n elements runs log2(n) merge stages, the k-th of which runs k compare-and-
+// swap sub-stages, for a total of log2(n)*(log2(n)+1)/2 calls to CompareAndSwap, i.e. O(log2(n)^2)
+// Sign evaluations, auto-bootstrapped by the receiver's bootstrapper as needed.
+func (eval ComparisonEvaluator) BitonicSort(rotEval Evaluator, ct *rlwe.Ciphertext, n int) (sorted *rlwe.Ciphertext, err error) {
+
+	if n <= 0 || n&(n-1) != 0 {
+		return nil, fmt.Errorf("cannot BitonicSort: n=%d must be a power of two", n)
+	}
+
+	if n > ct.Slots() {
+		return nil, fmt.Errorf("cannot BitonicSort: n=%d exceeds ct.Slots()=%d", n, ct.Slots())
+	}
+
+	sorted = ct.CopyNew()
+
+	for k := 2; k <= n; k <<= 1 {
+		for d := k >> 1; d > 0; d >>= 1 {
+			if sorted, err = eval.bitonicStage(rotEval, sorted, n, k, d); err != nil {
+				return nil, fmt.Errorf("cannot BitonicSort: %w", err)
+			}
+		}
+	}
+
+	return sorted, nil
+}
+
+// bitonicStage performs one compare-and-swap sub-stage of the bitonic network at distance d within
+// merge-blocks of size k: for every low index i (i.e. i&d == 0 and i < n), it compares slot i
+// against slot i+d and writes back the ascending or descending order depending on whether i belongs
+// to the lower or upper half of its size-k block.
+func (eval ComparisonEvaluator) bitonicStage(rotEval Evaluator, ct *rlwe.Ciphertext, n, k, d int) (out *rlwe.Ciphertext, err error) {
+
+	slots := ct.Slots()
+
+	// rot[i] = ct[i+d], so that, for a low index i (i&d == 0), ct and rot hold the two elements
+	// of the comparator (i, i+d) side by side at index i.
+	rot, err := rotEval.RotateNew(ct, d)
+	if err != nil {
+		return nil, fmt.Errorf("cannot bitonicStage: %w", err)
+	}
+
+	min, max, err := eval.CompareAndSwap(ct, rot)
+	if err != nil {
+		return nil, fmt.Errorf("cannot bitonicStage: %w", err)
+	}
+
+	// ascLow/descLow select, among the low indices, those whose comparator must end up ascending
+	// (min at i, max at i+d) or descending (max at i, min at i+d) respectively.
+	ascLow := make([]float64, slots)
+	descLow := make([]float64, slots)
+
+	for i := 0; i < n; i++ {
+		if i&d != 0 {
+			continue
+		}
+		if i&k == 0 {
+			ascLow[i] = 1
+		} else {
+			descLow[i] = 1
+		}
+	}
+
+	lowResult, err := eval.maskedSum(rotEval, min, ascLow, max, descLow)
+	if err != nil {
+		return nil, fmt.Errorf("cannot bitonicStage: %w", err)
+	}
+
+	highSource, err := eval.maskedSum(rotEval, max, ascLow, min, descLow)
+	if err != nil {
+		return nil, fmt.Errorf("cannot bitonicStage: %w", err)
+	}
+
+	// Shifts the high half of every comparator from index i to index i+d.
+	high, err := rotEval.RotateNew(highSource, -d)
+	if err != nil {
+		return nil, fmt.Errorf("cannot bitonicStage: %w", err)
+	}
+
+	if err = eval.Add(lowResult, high, lowResult); err != nil {
+		return nil, fmt.Errorf("cannot bitonicStage: %w", err)
+	}
+
+	return lowResult, nil
+}
+
+// maskedSum returns a*maskA + b*maskB, where maskA and maskB are 0/1 plaintext masks.
+func (eval ComparisonEvaluator) maskedSum(rotEval Evaluator, a *rlwe.Ciphertext, maskA []float64, b *rlwe.Ciphertext, maskB []float64) (sum *rlwe.Ciphertext, err error) {
+
+	params := *rotEval.GetParameters()
+
+	levelsPerRescaling := params.LevelsConsumedPerRescaling()
+
+	if a.Level() < levelsPerRescaling {
+		if a, err = eval.Bootstrap(a); err != nil {
+			return nil, err
+		}
+	}
+
+	if b.Level() < levelsPerRescaling {
+		if b, err = eval.Bootstrap(b); err != nil {
+			return nil, err
+		}
+	}
+
+	ptA := NewPlaintext(params, a.Level())
+	ptA.MetaData = a.MetaData
+	if err = rotEval.Encode(maskA, ptA); err != nil {
+		return nil, err
+	}
+
+	var termA *rlwe.Ciphertext
+	if termA, err = eval.MulNew(a, ptA); err != nil {
+		return nil, err
+	}
+	if err = eval.Rescale(termA, termA); err != nil {
+		return nil, err
+	}
+
+	ptB := NewPlaintext(params, b.Level())
+	ptB.MetaData = b.MetaData
+	if err = rotEval.Encode(maskB, ptB); err != nil {
+		return nil, err
+	}
+
+	var termB *rlwe.Ciphertext
+	if termB, err = eval.MulNew(b, ptB); err != nil {
+		return nil, err
+	}
+	if err = eval.Rescale(termB, termB); err != nil {
+		return nil, err
+	}
+
+	if err = eval.Add(termA, termB, termA); err != nil {
+		return nil, err
+	}
+
+	return termA, nil
+}
@@ -11,6 +11,21 @@ import (
 // Ensures that the Evaluator complies to the he.Bootstrapper interface
 var _ he.Bootstrapper[rlwe.Ciphertext] = (*Evaluator)(nil)
 
+// NewBootstrapper instantiates a new Evaluator together with all the evaluation keys that the
+// bootstrapping circuit needs under sk: the relinearization key, the CoeffsToSlots/SlotsToCoeffs
+// and conjugation Galois keys, and, when applicable, the ring-degree-switching and sparse/dense
+// encapsulation keys. It is a convenience wrapper around Parameters.GenEvaluationKeys followed by
+// NewEvaluator, sparing the caller from having to generate the bootstrapping keys by hand.
+func NewBootstrapper(btpParams Parameters, sk *rlwe.SecretKey) (eval *Evaluator, err error) {
+
+	evk, _, err := btpParams.GenEvaluationKeys(sk)
+	if err != nil {
+		return nil, fmt.Errorf("cannot NewBootstrapper: %w", err)
+	}
+
+	return NewEvaluator(btpParams, evk)
+}
+
 // Bootstrap bootstraps a single ciphertext and returns the bootstrapped ciphertext.
 func (eval Evaluator) Bootstrap(ct *rlwe.Ciphertext) (*rlwe.Ciphertext, error) {
 	cts := []rlwe.Ciphertext{*ct}
@@ -101,6 +101,72 @@ func TestBootstrapping(t *testing.T) {
 		})
 	})
 
+	t.Run("NewBootstrapper", func(t *testing.T) {
+
+		schemeParamsLit := testPrec45
+		btpParamsLit := ParametersLiteral{}
+
+		if *flagLongTest {
+			schemeParamsLit.LogN = 16
+		}
+
+		params, err := hefloat.NewParametersFromLiteral(schemeParamsLit)
+		require.Nil(t, err)
+
+		btpParamsLit.LogN = utils.Pointy(params.LogN())
+
+		btpParams, err := NewParametersFromLiteral(params, btpParamsLit)
+		require.Nil(t, err)
+
+		// Insecure params for fast testing only
+		if !*flagLongTest {
+			btpParams.SlotsToCoeffsParameters.LogSlots = btpParams.BootstrappingParameters.LogN() - 1
+			btpParams.CoeffsToSlotsParameters.LogSlots = btpParams.BootstrappingParameters.LogN() - 1
+
+			// Corrects the message ratio to take into account the smaller number of slots and keep the same precision
+			btpParams.Mod1ParametersLiteral.LogMessageRatio += 16 - params.LogN()
+		}
+
+		sk := rlwe.NewKeyGenerator(btpParams.BootstrappingParameters).GenSecretKeyNew()
+
+		// NewBootstrapper generates the bootstrapping keys and the Evaluator in one call,
+		// without the caller having to generate the keys itself.
+		evaluator, err := NewBootstrapper(btpParams, sk)
+		require.NoError(t, err)
+
+		ecd := hefloat.NewEncoder(params)
+		enc := rlwe.NewEncryptor(params, sk)
+		dec := rlwe.NewDecryptor(params, sk)
+
+		values := make([]complex128, params.MaxSlots())
+		for i := range values {
+			values[i] = sampling.RandComplex128(-1, 1)
+		}
+
+		values[0] = complex(0.9238795325112867, 0.3826834323650898)
+		values[1] = complex(0.9238795325112867, 0.3826834323650898)
+		if len(values) > 2 {
+			values[2] = complex(0.9238795325112867, 0.3826834323650898)
+			values[3] = complex(0.9238795325112867, 0.3826834323650898)
+		}
+
+		plaintext := hefloat.NewPlaintext(params, 0)
+		ecd.Encode(values, plaintext)
+
+		ctQ0, err := enc.EncryptNew(plaintext)
+		require.NoError(t, err)
+
+		require.True(t, ctQ0.Level() == 0)
+
+		ctQL, err := evaluator.Bootstrap(ctQ0)
+		require.NoError(t, err)
+
+		require.True(t, ctQL.Level() == params.MaxLevel())
+		require.True(t, ctQL.Scale.Equal(params.DefaultScale()))
+
+		verifyTestVectorsBootstrapping(params, ecd, dec, values, ctQL, t)
+	})
+
 	t.Run("BootstrappingWithRingDegreeSwitch", func(t *testing.T) {
 
 		schemeParamsLit := testPrec45
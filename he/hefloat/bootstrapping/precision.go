@@ -0,0 +1,42 @@
+package bootstrapping
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+	"github.com/tuneinsight/lattigo/v5/he/hefloat"
+	"github.com/tuneinsight/lattigo/v5/schemes/ckks"
+	"github.com/tuneinsight/lattigo/v5/utils/sampling"
+)
+
+// MeasureBootstrapPrecision encrypts a random vector with values in [-1, 1] at the residual
+// parameters' maximum level, bootstraps it with eval, and returns the ckks.PrecisionStats between
+// the values that were encrypted and the bootstrapped ciphertext. It is a convenience for measuring,
+// e.g. during bootstrapping parameter tuning, how many bits of precision a single bootstrapping
+// consumes, without having to hand-roll the encode/encrypt/bootstrap/decrypt/decode sequence.
+func MeasureBootstrapPrecision(eval *Evaluator, encoder *hefloat.Encoder, encryptor *rlwe.Encryptor, decryptor *rlwe.Decryptor) (prec ckks.PrecisionStats, err error) {
+
+	params := eval.ResidualParameters
+
+	values := make([]float64, params.MaxSlots())
+	for i := range values {
+		values[i] = sampling.RandFloat64(-1, 1)
+	}
+
+	pt := hefloat.NewPlaintext(params, params.MaxLevel())
+	if err = encoder.Encode(values, pt); err != nil {
+		return ckks.PrecisionStats{}, fmt.Errorf("cannot MeasureBootstrapPrecision: %w", err)
+	}
+
+	ct, err := encryptor.EncryptNew(pt)
+	if err != nil {
+		return ckks.PrecisionStats{}, fmt.Errorf("cannot MeasureBootstrapPrecision: %w", err)
+	}
+
+	ctOut, err := eval.Bootstrap(ct)
+	if err != nil {
+		return ckks.PrecisionStats{}, fmt.Errorf("cannot MeasureBootstrapPrecision: %w", err)
+	}
+
+	return hefloat.GetPrecisionStats(params, encoder, decryptor, values, ctOut, 0, false), nil
+}
@@ -0,0 +1,66 @@
+package bootstrapping
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+	"github.com/tuneinsight/lattigo/v5/he/hefloat"
+	"github.com/tuneinsight/lattigo/v5/utils"
+)
+
+// TestMeasureBootstrapPrecision checks that the precision loss of a single bootstrapping, as
+// reported by MeasureBootstrapPrecision, stays above a usable bound. N16QP1546H192H32, the
+// parameter set used here, documents a precision of 26.6 bits at its full LogN=16; the short test
+// suite runs a smaller LogN for speed, for which that exact bound does not apply, so it only
+// checks against the generic minPrec also used by the other bootstrapping tests in this package.
+func TestMeasureBootstrapPrecision(t *testing.T) {
+
+	if runtime.GOARCH == "wasm" {
+		t.Skip("skipping bootstrapping tests for GOARCH=wasm")
+	}
+
+	paramSet := DefaultParametersSparse[0]
+
+	if !*flagLongTest {
+		paramSet.SchemeParams.LogN -= 3
+	}
+
+	paramSet.BootstrappingParams.LogN = utils.Pointy(paramSet.SchemeParams.LogN)
+
+	params, err := hefloat.NewParametersFromLiteral(paramSet.SchemeParams)
+	require.NoError(t, err)
+
+	btpParams, err := NewParametersFromLiteral(params, paramSet.BootstrappingParams)
+	require.NoError(t, err)
+
+	if !*flagLongTest {
+		btpParams.Mod1ParametersLiteral.LogMessageRatio += 16 - params.LogN()
+	}
+
+	sk := rlwe.NewKeyGenerator(btpParams.BootstrappingParameters).GenSecretKeyNew()
+
+	btpKeys, _, err := btpParams.GenEvaluationKeys(sk)
+	require.NoError(t, err)
+
+	eval, err := NewEvaluator(btpParams, btpKeys)
+	require.NoError(t, err)
+
+	encoder := hefloat.NewEncoder(params)
+	encryptor := rlwe.NewEncryptor(params, sk)
+	decryptor := rlwe.NewDecryptor(params, sk)
+
+	prec, err := MeasureBootstrapPrecision(eval, encoder, encryptor, decryptor)
+	require.NoError(t, err)
+
+	if *printPrecisionStats {
+		t.Log(prec.String())
+	}
+
+	rf64, _ := prec.MeanPrecision.Real.Float64()
+	if64, _ := prec.MeanPrecision.Imag.Float64()
+
+	require.GreaterOrEqual(t, rf64, minPrec)
+	require.GreaterOrEqual(t, if64, minPrec)
+}
@@ -136,15 +136,111 @@ func (eval ComparisonEvaluator) Min(op0, op1 *rlwe.Ciphertext) (min *rlwe.Cipher
 	return stepdiff, nil
 }
 
+// CompareAndSwap returns the smooth min and max of op0 and op1, i.e. it is equivalent to calling
+// both Min and Max on op0 and op1, but shares their common sign evaluation so that it costs a
+// single Step instead of two. This is the compare-and-swap primitive of a bitonic sorting network:
+// given two slots, it returns which one is smaller and which one is larger without revealing which
+// input each one came from.
+//
+// Use must ensure that:
+//   - op0 + op1 is in the interval [-1, 1].
+//   - op0.Scale = op1.Scale.
+//
+// This method ensures that min.Scale = max.Scale = params.DefaultScale.
+func (eval ComparisonEvaluator) CompareAndSwap(op0, op1 *rlwe.Ciphertext) (min, max *rlwe.Ciphertext, err error) {
+
+	// step * diff
+	var stepdiff *rlwe.Ciphertext
+	if stepdiff, err = eval.stepdiff(op0, op1); err != nil {
+		return
+	}
+
+	// min = op0 - step * diff
+	if min, err = eval.SubNew(op0, stepdiff); err != nil {
+		return
+	}
+
+	// max = step * diff + op1
+	if max, err = eval.AddNew(stepdiff, op1); err != nil {
+		return
+	}
+
+	return min, max, nil
+}
+
+// MaxConst returns the smooth maximum of op0 and the plaintext constant c, which is defined as:
+// op0 * x + c * (1-x) where x = step(diff = op0-c).
+// Use must ensure that:
+//   - op0 and c are both in the interval [-1, 1].
+//
+// This method ensures that max.Scale = params.DefaultScale.
+func (eval ComparisonEvaluator) MaxConst(op0 *rlwe.Ciphertext, c float64) (max *rlwe.Ciphertext, err error) {
+
+	// step * diff
+	var stepdiff *rlwe.Ciphertext
+	if stepdiff, err = eval.stepdiffConst(op0, c); err != nil {
+		return
+	}
+
+	// max = step * diff + c
+	if err = eval.Add(stepdiff, c, stepdiff); err != nil {
+		return
+	}
+
+	return stepdiff, nil
+}
+
+// MinConst returns the smooth minimum of op0 and the plaintext constant c, which is defined as:
+// op0 * (1-x) + c * x where x = step(diff = op0-c).
+// Use must ensure that:
+//   - op0 and c are both in the interval [-1, 1].
+//
+// This method ensures that min.Scale = params.DefaultScale.
+//
+// MaxConst(op0, 0) is a smooth approximation of ReLU(op0) = max(op0, 0).
+func (eval ComparisonEvaluator) MinConst(op0 *rlwe.Ciphertext, c float64) (min *rlwe.Ciphertext, err error) {
+
+	// step * diff
+	var stepdiff *rlwe.Ciphertext
+	if stepdiff, err = eval.stepdiffConst(op0, c); err != nil {
+		return
+	}
+
+	// min = op0 - step * diff
+	if err = eval.Sub(op0, stepdiff, stepdiff); err != nil {
+		return
+	}
+
+	return stepdiff, nil
+}
+
 func (eval ComparisonEvaluator) stepdiff(op0, op1 *rlwe.Ciphertext) (stepdiff *rlwe.Ciphertext, err error) {
-	params := eval.Parameters
 
 	// diff = op0 - op1
 	var diff *rlwe.Ciphertext
-	if diff, err = eval.SubNew(op0, op1); err != nil {
+	var err0 error
+	if diff, err0 = eval.SubNew(op0, op1); err0 != nil {
+		return nil, err0
+	}
+
+	return eval.stepTimesDiff(diff)
+}
+
+func (eval ComparisonEvaluator) stepdiffConst(op0 *rlwe.Ciphertext, c float64) (stepdiff *rlwe.Ciphertext, err error) {
+
+	// diff = op0 - c
+	var diff *rlwe.Ciphertext
+	if diff, err = eval.SubNew(op0, c); err != nil {
 		return
 	}
 
+	return eval.stepTimesDiff(diff)
+}
+
+// stepTimesDiff returns step(diff) * diff, where step is the smooth step function, consuming diff in place.
+func (eval ComparisonEvaluator) stepTimesDiff(diff *rlwe.Ciphertext) (stepdiff *rlwe.Ciphertext, err error) {
+	params := eval.Parameters
+
 	// Required for the scale matching before the last multiplication.
 	if diff.Level() < params.LevelsConsumedPerRescaling()*2 {
 		if diff, err = eval.Bootstrap(diff); err != nil {
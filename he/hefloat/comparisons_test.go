@@ -8,6 +8,7 @@ import (
 	"github.com/tuneinsight/lattigo/v5/he/hefloat"
 	"github.com/tuneinsight/lattigo/v5/he/hefloat/bootstrapping"
 	"github.com/tuneinsight/lattigo/v5/ring"
+	"github.com/tuneinsight/lattigo/v5/utils/bignum"
 
 	"github.com/stretchr/testify/require"
 )
@@ -45,6 +46,11 @@ func TestComparisons(t *testing.T) {
 			galKeys = append(galKeys, kgen.GenGaloisKeyNew(params.GaloisElementForComplexConjugation(), sk))
 		}
 
+		// Rotations needed by the BitonicSort test below, sorting its first 4 slots.
+		for _, k := range []int{1, -1, 2, -2} {
+			galKeys = append(galKeys, kgen.GenGaloisKeyNew(params.GaloisElementForRotation(k), sk))
+		}
+
 		eval := tc.evaluator.WithKey(rlwe.NewMemEvaluationKeySet(kgen.GenRelinearizationKeyNew(sk), galKeys...))
 
 		polys := hefloat.NewMinimaxCompositePolynomial(hefloat.DefaultMinimaxCompositePolynomialForSign)
@@ -150,5 +156,211 @@ func TestComparisons(t *testing.T) {
 
 			hefloat.VerifyTestVectors(params, ecd, nil, want, have, params.LogDefaultScale(), 0, *printPrecisionStats, t)
 		})
+
+		t.Run(GetTestName(params, "MaxConst/ReLU"), func(t *testing.T) {
+
+			values, _, ct := newTestVectors(tc, enc, complex(-0.5, 0), complex(0.5, 0), t)
+
+			relu, err := CmpEval.MaxConst(ct, 0)
+			require.NoError(t, err)
+
+			have := make([]*big.Float, params.MaxSlots())
+
+			require.NoError(t, ecd.Decode(dec.DecryptNew(relu), have))
+
+			want := make([]*big.Float, params.MaxSlots())
+
+			zero := new(big.Float)
+			for i := range have {
+				if values[i][0].Cmp(zero) == -1 {
+					want[i] = zero
+				} else {
+					want[i] = values[i][0]
+				}
+			}
+
+			hefloat.VerifyTestVectors(params, ecd, nil, want, have, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		})
+
+		t.Run(GetTestName(params, "MinConst"), func(t *testing.T) {
+
+			values, _, ct := newTestVectors(tc, enc, complex(-0.5, 0), complex(0.5, 0), t)
+
+			min, err := CmpEval.MinConst(ct, 0)
+			require.NoError(t, err)
+
+			have := make([]*big.Float, params.MaxSlots())
+
+			require.NoError(t, ecd.Decode(dec.DecryptNew(min), have))
+
+			want := make([]*big.Float, params.MaxSlots())
+
+			zero := new(big.Float)
+			for i := range have {
+				if values[i][0].Cmp(zero) == 1 {
+					want[i] = zero
+				} else {
+					want[i] = values[i][0]
+				}
+			}
+
+			hefloat.VerifyTestVectors(params, ecd, nil, want, have, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		})
+
+		t.Run(GetTestName(params, "CompareAndSwap"), func(t *testing.T) {
+
+			values0, _, ct0 := newTestVectors(tc, enc, complex(-0.5, 0), complex(0.5, 0), t)
+			values1, _, ct1 := newTestVectors(tc, enc, complex(-0.5, 0), complex(0.5, 0), t)
+
+			min, max, err := CmpEval.CompareAndSwap(ct0, ct1)
+			require.NoError(t, err)
+
+			haveMin := make([]*big.Float, params.MaxSlots())
+			haveMax := make([]*big.Float, params.MaxSlots())
+
+			require.NoError(t, ecd.Decode(dec.DecryptNew(min), haveMin))
+			require.NoError(t, ecd.Decode(dec.DecryptNew(max), haveMax))
+
+			wantMin := make([]*big.Float, params.MaxSlots())
+			wantMax := make([]*big.Float, params.MaxSlots())
+
+			for i := range wantMin {
+				if values0[i][0].Cmp(values1[i][0]) == 1 {
+					wantMin[i], wantMax[i] = values1[i][0], values0[i][0]
+				} else {
+					wantMin[i], wantMax[i] = values0[i][0], values1[i][0]
+				}
+			}
+
+			hefloat.VerifyTestVectors(params, ecd, nil, wantMin, haveMin, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+			hefloat.VerifyTestVectors(params, ecd, nil, wantMax, haveMax, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		})
+
+		t.Run(GetTestName(params, "BitonicSort"), func(t *testing.T) {
+
+			const n = 4
+
+			raw := [n]float64{0.3, -0.4, 0.1, -0.2}
+
+			values := make([]complex128, params.MaxSlots())
+			for i, v := range raw {
+				values[i] = complex(v, 0)
+			}
+
+			pt := hefloat.NewPlaintext(params, params.MaxLevel())
+			require.NoError(t, ecd.Encode(values, pt))
+
+			ct, err := enc.EncryptNew(pt)
+			require.NoError(t, err)
+
+			sorted, err := CmpEval.BitonicSort(*eval, ct, n)
+			require.NoError(t, err)
+
+			have := make([]*big.Float, params.MaxSlots())
+			require.NoError(t, ecd.Decode(dec.DecryptNew(sorted), have))
+
+			want := make([]*big.Float, n)
+			for i, v := range raw {
+				want[i] = new(big.Float).SetFloat64(v)
+			}
+			for i := 1; i < n; i++ {
+				for j := i; j > 0 && want[j-1].Cmp(want[j]) == 1; j-- {
+					want[j-1], want[j] = want[j], want[j-1]
+				}
+			}
+
+			// Each of the log2(n)*(log2(n)+1)/2 compare-and-swap stages re-masks and re-adds its
+			// inputs, compounding the scheme error of every stage's Sign evaluation, so the
+			// end-to-end precision is markedly lower than a single CompareAndSwap's.
+			hefloat.VerifyTestVectors(params, ecd, nil, want, have[:n], 25, 0, *printPrecisionStats, t)
+		})
+
+		t.Run(GetTestName(params, "TopKMask"), func(t *testing.T) {
+
+			const n = 4
+			const k = 2
+
+			// Well separated so that Step can tell the 2nd and 3rd largest values apart.
+			raw := [n]float64{0.3, -0.4, 0.1, -0.2}
+
+			values := make([]complex128, params.MaxSlots())
+			for i, v := range raw {
+				values[i] = complex(v, 0)
+			}
+
+			pt := hefloat.NewPlaintext(params, params.MaxLevel())
+			require.NoError(t, ecd.Encode(values, pt))
+
+			ct, err := enc.EncryptNew(pt)
+			require.NoError(t, err)
+
+			mask, err := CmpEval.TopKMask(*eval, ct, n, k)
+			require.NoError(t, err)
+
+			have := make([]*big.Float, params.MaxSlots())
+			require.NoError(t, ecd.Decode(dec.DecryptNew(mask), have))
+
+			// The 2 largest of raw are indices 0 (0.3) and 2 (0.1).
+			want := make([]*big.Float, n)
+			for i := range want {
+				want[i] = new(big.Float)
+			}
+			want[0].SetFloat64(1)
+			want[2].SetFloat64(1)
+
+			hefloat.VerifyTestVectors(params, ecd, nil, want, have[:n], 10, 0, *printPrecisionStats, t)
+		})
+
+		t.Run(GetTestName(params, "Abs/Sign"), func(t *testing.T) {
+
+			values, _, ct := newTestVectors(tc, enc, complex(-1, 0), complex(1, 0), t)
+
+			abs, err := CmpEval.Abs(ct, hefloat.AbsSign, 0)
+			require.NoError(t, err)
+
+			have := make([]*big.Float, params.MaxSlots())
+
+			require.NoError(t, ecd.Decode(dec.DecryptNew(abs), have))
+
+			want := make([]*big.Float, params.MaxSlots())
+
+			for i := range have {
+				want[i] = new(big.Float).Abs(values[i][0])
+			}
+
+			hefloat.VerifyTestVectors(params, ecd, nil, want, have, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		})
+
+		t.Run(GetTestName(params, "Abs/Sqrt"), func(t *testing.T) {
+
+			// AbsSqrt's Newton-Raphson iteration for 1/sqrt(x^2) is seeded for |x| close to 1 and
+			// converges too slowly near 0 to be meaningfully precise there (see the AbsSqrt doc),
+			// so, as with the domain truncation in TestInverse, values below this threshold are
+			// excluded from the precision check.
+			const iterations = 10
+			const eps = 0.0625 // 2^-4
+
+			values, _, ct := newTestVectors(tc, enc, complex(-1, 0), complex(1, 0), t)
+
+			abs, err := CmpEval.Abs(ct, hefloat.AbsSqrt, iterations)
+			require.NoError(t, err)
+
+			have := make([]*big.Float, params.MaxSlots())
+
+			require.NoError(t, ecd.Decode(dec.DecryptNew(abs), have))
+
+			want := make([]*big.Float, params.MaxSlots())
+
+			threshold := bignum.NewFloat(eps, params.EncodingPrecision())
+			for i := range have {
+				if new(big.Float).Abs(values[i][0]).Cmp(threshold) == -1 {
+					want[i] = have[i] // Ignores values below the threshold
+				} else {
+					want[i] = new(big.Float).Abs(values[i][0])
+				}
+			}
+
+			hefloat.VerifyTestVectors(params, ecd, nil, want, have, 15, 0, *printPrecisionStats, t)
+		})
 	}
 }
@@ -3,6 +3,8 @@ package hefloat_test
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"math/big"
 	"runtime"
 	"testing"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/tuneinsight/lattigo/v5/he/hefloat"
 	"github.com/tuneinsight/lattigo/v5/ring"
 	"github.com/tuneinsight/lattigo/v5/schemes/ckks"
+	"github.com/tuneinsight/lattigo/v5/utils/bignum"
 	"github.com/tuneinsight/lattigo/v5/utils/sampling"
 )
 
@@ -435,4 +438,26 @@ func benchEvaluator(tc *testContext, b *testing.B) {
 			}
 		}
 	})
+
+	// Evaluating a degree-31 Chebyshev polynomial invokes the Paterson-Stockmeyer / baby-step-giant-step
+	// decomposition of he.EvaluatePolynomial, which brings the depth down to ceil(log2(degree+1)) levels
+	// and the number of ciphertext-ciphertext multiplications down to roughly sqrt(degree) instead of degree.
+	b.Run(GetBenchName(params, "Evaluator/EvaluatePolynomial/ChebyshevDegree31"), func(b *testing.B) {
+
+		if params.MaxLevel() < 7*params.LevelsConsumedPerRescaling() {
+			b.Skip("benchmark requires enough levels to evaluate a degree-31 polynomial")
+		}
+
+		poly := bignum.ChebyshevApproximation(math.Sin, bignum.Interval{A: *big.NewFloat(-1), B: *big.NewFloat(1), Nodes: 31})
+
+		polyEval := hefloat.NewPolynomialEvaluator(params, eval)
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := polyEval.Evaluate(ciphertext1, poly, ciphertext1.Scale); err != nil {
+				b.Log(err)
+				b.Fail()
+			}
+		}
+	})
 }
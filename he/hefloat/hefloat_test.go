@@ -85,6 +85,9 @@ func TestFloat(t *testing.T) {
 			for _, testSet := range []func(tc *testContext, t *testing.T){
 				testLinearTransformation,
 				testEvaluatePolynomial,
+				testEvaluatePolynomialInPlace,
+				testMovingAverage,
+				testEncryptVector,
 			} {
 				testSet(tc, t)
 				runtime.GC()
@@ -265,6 +268,74 @@ func testLinearTransformation(tc *testContext, t *testing.T) {
 		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, values, ciphertext, params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
 
+	t.Run(GetTestName(params, "LinearTransform/Marshalling"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		slots := ciphertext.Slots()
+
+		nonZeroDiags := []int{-15, -4, -1, 0, 1, 2, 3, 4, 15}
+
+		one := new(big.Float).SetInt64(1)
+		zero := new(big.Float)
+
+		diagonals := make(hefloat.Diagonals[*bignum.Complex])
+		for _, i := range nonZeroDiags {
+			diagonals[i] = make([]*bignum.Complex, slots)
+
+			for j := 0; j < slots; j++ {
+				diagonals[i][j] = &bignum.Complex{one, zero}
+			}
+		}
+
+		ltparams := hefloat.LinearTransformationParameters{
+			DiagonalsIndexList:       nonZeroDiags,
+			Level:                    ciphertext.Level(),
+			Scale:                    rlwe.NewScale(params.Q()[ciphertext.Level()]),
+			LogDimensions:            ciphertext.LogDimensions,
+			LogBabyStepGianStepRatio: 1,
+		}
+
+		// Allocate and encode the linear transformation
+		linTransf := hefloat.NewLinearTransformation(params, ltparams)
+		require.NoError(t, hefloat.EncodeLinearTransformation[*bignum.Complex](tc.encoder, diagonals, linTransf))
+
+		// Marshals and unmarshals it into a fresh LinearTransformation
+		buf, err := linTransf.MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, len(buf), linTransf.BinarySize())
+
+		var linTransfHave hefloat.LinearTransformation
+		require.NoError(t, linTransfHave.UnmarshalBinary(buf))
+
+		galEls := hefloat.GaloisElementsForLinearTransformation(params, ltparams)
+
+		evk := rlwe.NewMemEvaluationKeySet(nil, tc.kgen.GenGaloisKeysNew(galEls, tc.sk)...)
+
+		ltEval := hefloat.NewLinearTransformationEvaluator(tc.evaluator.WithKey(evk))
+
+		// Evaluates using the deserialized linear transformation
+		require.NoError(t, ltEval.Evaluate(ciphertext, linTransfHave, ciphertext))
+
+		tmp := make([]*bignum.Complex, len(values))
+		for i := range tmp {
+			tmp[i] = values[i].Clone()
+		}
+
+		for i := 0; i < slots; i++ {
+			values[i].Add(values[i], tmp[(i-15+slots)%slots])
+			values[i].Add(values[i], tmp[(i-4+slots)%slots])
+			values[i].Add(values[i], tmp[(i-1+slots)%slots])
+			values[i].Add(values[i], tmp[(i+1)%slots])
+			values[i].Add(values[i], tmp[(i+2)%slots])
+			values[i].Add(values[i], tmp[(i+3)%slots])
+			values[i].Add(values[i], tmp[(i+4)%slots])
+			values[i].Add(values[i], tmp[(i+15)%slots])
+		}
+
+		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, values, ciphertext, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+
 	t.Run(GetTestName(params, "LinearTransform/BSGS=False"), func(t *testing.T) {
 
 		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
@@ -325,6 +396,39 @@ func testLinearTransformation(tc *testContext, t *testing.T) {
 
 		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, values, ciphertext, params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
+
+	t.Run(GetTestName(params, "Transpose"), func(t *testing.T) {
+
+		dim := 4
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		slots := ciphertext.Slots()
+
+		galEls := hefloat.GaloisElementsForTranspose(params, dim)
+
+		evk := rlwe.NewMemEvaluationKeySet(nil, tc.kgen.GenGaloisKeysNew(galEls, tc.sk)...)
+
+		ltEval := hefloat.NewLinearTransformationEvaluator(tc.evaluator.WithKey(evk))
+
+		ciphertext, err := hefloat.Transpose(ltEval, tc.encoder, params, ciphertext, dim)
+		require.NoError(t, err)
+
+		want := make([]*bignum.Complex, slots)
+		for i := range want {
+			want[i] = values[i].Clone()
+		}
+
+		for base := 0; base+dim*dim <= slots; base += dim * dim {
+			for j := 0; j < dim; j++ {
+				for i := 0; i < dim; i++ {
+					want[base+j*dim+i] = values[base+i*dim+j]
+				}
+			}
+		}
+
+		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, want, ciphertext, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
 }
 
 func testEvaluatePolynomial(tc *testContext, t *testing.T) {
@@ -416,4 +520,146 @@ func testEvaluatePolynomial(tc *testContext, t *testing.T) {
 
 		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, valuesWant, ciphertext, params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
+
+	t.Run(GetTestName(params, "EvaluatePoly/OutputScale"), func(t *testing.T) {
+
+		if params.MaxLevel() < 3 {
+			t.Skip("skipping test for params max level < 3")
+		}
+
+		_, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1, 1, t)
+
+		prec := tc.encoder.Prec()
+
+		poly := bignum.NewPolynomial(bignum.Monomial, []*big.Float{
+			bignum.NewFloat(1, prec),
+			bignum.NewFloat(1, prec),
+			bignum.NewFloat(1, prec),
+			bignum.NewFloat(1, prec),
+		}, nil)
+
+		// targetScale deliberately differs from ciphertext.Scale, to check that the output scale
+		// tracks targetScale and not some function of the input scale or of the polynomial.
+		targetScale := ciphertext.Scale.Mul(rlwe.NewScale(2))
+
+		opOut, err := polyEval.Evaluate(ciphertext, poly, targetScale)
+		require.NoError(t, err)
+		require.True(t, opOut.Scale.InDelta(targetScale, 40), "Evaluate's output scale must match targetScale")
+	})
+
+	t.Run(GetTestName(params, "EvaluatePoly/OutputScale/Chained"), func(t *testing.T) {
+
+		// Chaining two independent polynomial evaluations (as in an EvalMod-style pipeline, where
+		// one polynomial's output feeds the next) must land each stage on its own requested scale
+		// regardless of what scale the previous stage happened to produce.
+		if params.MaxLevel() < 6 {
+			t.Skip("skipping test for params max level < 6")
+		}
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1, 1, t)
+
+		prec := tc.encoder.Prec()
+
+		poly1 := bignum.NewPolynomial(bignum.Monomial, []*big.Float{
+			bignum.NewFloat(0, prec),
+			bignum.NewFloat(1, prec),
+			bignum.NewFloat(1, prec),
+		}, nil)
+
+		poly2 := bignum.NewPolynomial(bignum.Monomial, []*big.Float{
+			bignum.NewFloat(1, prec),
+			bignum.NewFloat(0, prec),
+			bignum.NewFloat(1, prec),
+		}, nil)
+
+		midScale := ciphertext.Scale.Mul(rlwe.NewScale(2))
+		finalScale := params.DefaultScale()
+
+		mid, err := polyEval.Evaluate(ciphertext, poly1, midScale)
+		require.NoError(t, err)
+		require.True(t, mid.Scale.InDelta(midScale, 40), "first stage's output scale must match its targetScale")
+
+		final, err := polyEval.Evaluate(mid, poly2, finalScale)
+		require.NoError(t, err)
+		require.True(t, final.Scale.InDelta(finalScale, 40), "second stage's output scale must match its targetScale, independently of the first stage's scale")
+
+		for i := range values {
+			values[i] = poly2.Evaluate(poly1.Evaluate(values[i]))
+		}
+
+		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, values, final, params.LogDefaultScale()-10, 0, *printPrecisionStats, t)
+	})
+
+	t.Run(GetTestName(params, "EvaluatePoly/Chebyshev/Degree16"), func(t *testing.T) {
+
+		// Degree-16 approximations are evaluated by he.EvaluatePolynomial using a
+		// Paterson-Stockmeyer / baby-step-giant-step decomposition, which brings the
+		// depth down from 16 to ceil(log2(16)) = 4 levels and the ciphertext-ciphertext
+		// multiplication count down to roughly sqrt(16) = 4 instead of 16.
+		if params.MaxLevel() < 4*params.LevelsConsumedPerRescaling() {
+			t.Skip("skipping test: not enough levels to evaluate a degree-16 polynomial")
+		}
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1, 1, t)
+
+		prec := tc.encoder.Prec()
+
+		poly := bignum.ChebyshevApproximation(math.Sin, bignum.Interval{
+			Nodes: 16,
+			A:     *bignum.NewFloat(-1, prec),
+			B:     *bignum.NewFloat(1, prec),
+		})
+
+		for i := range values {
+			values[i] = poly.Evaluate(values[i])
+		}
+
+		if ciphertext, err = polyEval.Evaluate(ciphertext, poly, ciphertext.Scale); err != nil {
+			t.Fatal(err)
+		}
+
+		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, values, ciphertext, params.LogDefaultScale()-10, 0, *printPrecisionStats, t)
+	})
+}
+
+func testEvaluatePolynomialInPlace(tc *testContext, t *testing.T) {
+
+	params := tc.params
+
+	polyEval := hefloat.NewPolynomialEvaluator(params, tc.evaluator)
+
+	t.Run(GetTestName(params, "EvaluatePoly/InPlace/Exp"), func(t *testing.T) {
+
+		if params.MaxLevel() < 3 {
+			t.Skip("skipping test for params max level < 3")
+		}
+
+		_, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1, 1, t)
+
+		prec := tc.encoder.Prec()
+
+		coeffs := []*big.Float{
+			bignum.NewFloat(1, prec),
+			bignum.NewFloat(1, prec),
+			new(big.Float).Quo(bignum.NewFloat(1, prec), bignum.NewFloat(2, prec)),
+			new(big.Float).Quo(bignum.NewFloat(1, prec), bignum.NewFloat(6, prec)),
+			new(big.Float).Quo(bignum.NewFloat(1, prec), bignum.NewFloat(24, prec)),
+			new(big.Float).Quo(bignum.NewFloat(1, prec), bignum.NewFloat(120, prec)),
+			new(big.Float).Quo(bignum.NewFloat(1, prec), bignum.NewFloat(720, prec)),
+			new(big.Float).Quo(bignum.NewFloat(1, prec), bignum.NewFloat(5040, prec)),
+		}
+
+		poly := bignum.NewPolynomial(bignum.Monomial, coeffs, nil)
+
+		ciphertextWant := ciphertext.CopyNew()
+
+		// allocating version, used as the reference
+		ciphertextWant, err := polyEval.Evaluate(ciphertextWant, poly, ciphertextWant.Scale)
+		require.NoError(t, err)
+
+		// in-place version, reusing ciphertext's backing buffer
+		require.NoError(t, polyEval.EvaluateInPlace(ciphertext, poly, ciphertext.Scale))
+
+		require.True(t, ciphertext.Equal(ciphertextWant))
+	})
 }
@@ -0,0 +1,136 @@
+package hefloat
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+	"github.com/tuneinsight/lattigo/v5/utils/bignum"
+)
+
+// GaloisElementsForHistogram returns the list of Galois elements necessary to evaluate HistogramNew
+// with parameters n and numBuckets.
+func GaloisElementsForHistogram(params Parameters, n, numBuckets int) (galEls []uint64) {
+
+	rotations := make([]int, numBuckets-1)
+	for i := range rotations {
+		rotations[i] = -(i + 1)
+	}
+
+	return append(params.GaloisElementsForInnerSum(1, n), params.GaloisElements(rotations)...)
+}
+
+// HistogramNew homomorphically computes a soft histogram of the values encoded in the first n slots
+// of ctIn: for every center in bucketCenters, it evaluates the Gaussian soft-assignment kernel
+// exp(-(x-center)^2/(2*bandwidth^2)) on ctIn and sums its n slots (InnerSum), placing the resulting
+// soft count of bucket i in slot i of the returned ciphertext. Slots n to Slots-1 of ctIn are assumed
+// to be zero; their contribution to the bucket counts is otherwise undefined.
+//
+// This is a *soft* histogram: a value does not fall into exactly one bucket, it contributes to every
+// bucket with a weight that decays with its distance to that bucket's center, controlled by
+// bandwidth. A narrower bandwidth approximates a hard histogram more closely but requires a
+// higher-degree (and therefore more expensive and less precise) polynomial approximation of the
+// kernel.
+//
+// The kernel is Chebyshev-approximated by a degree-degree polynomial over [domain[0], domain[1]];
+// values of ctIn outside that range are not guaranteed to be binned correctly. This method requires
+// a rlwe.RelinearizationKey and the rlwe.GaloisKeys returned by GaloisElementsForHistogram for n and
+// len(bucketCenters).
+func (eval Evaluator) HistogramNew(ctIn *rlwe.Ciphertext, bucketCenters []float64, bandwidth float64, n, degree int, domain [2]float64) (opOut *rlwe.Ciphertext, err error) {
+
+	if n < 1 || n > ctIn.Slots() {
+		return nil, fmt.Errorf("cannot HistogramNew: n must be in [1, %d], but is %d", ctIn.Slots(), n)
+	}
+
+	if len(bucketCenters) < 1 || len(bucketCenters) > ctIn.Slots() {
+		return nil, fmt.Errorf("cannot HistogramNew: len(bucketCenters) must be in [1, %d], but is %d", ctIn.Slots(), len(bucketCenters))
+	}
+
+	polyEval := NewPolynomialEvaluator(*eval.GetParameters(), eval)
+
+	interval := bignum.Interval{
+		Nodes: degree,
+		A:     *new(big.Float).SetFloat64(domain[0]),
+		B:     *new(big.Float).SetFloat64(domain[1]),
+	}
+
+	for i, center := range bucketCenters {
+
+		count, err := eval.softBucketCount(polyEval, ctIn, center, bandwidth, n, interval)
+		if err != nil {
+			return nil, fmt.Errorf("cannot HistogramNew: bucket %d: %w", i, err)
+		}
+
+		if i == 0 {
+			opOut = count
+			continue
+		}
+
+		if err = eval.Rotate(count, -i, count); err != nil {
+			return nil, fmt.Errorf("cannot HistogramNew: bucket %d: %w", i, err)
+		}
+
+		if err = eval.Add(opOut, count, opOut); err != nil {
+			return nil, fmt.Errorf("cannot HistogramNew: bucket %d: %w", i, err)
+		}
+	}
+
+	return opOut, nil
+}
+
+// softBucketCount evaluates the Gaussian kernel centered on center with standard deviation bandwidth
+// on ctIn, sums its first n slots, and masks every slot but the first to zero, leaving the soft count
+// of the bucket in slot 0.
+func (eval Evaluator) softBucketCount(polyEval *PolynomialEvaluator, ctIn *rlwe.Ciphertext, center, bandwidth float64, n int, interval bignum.Interval) (count *rlwe.Ciphertext, err error) {
+
+	kernel := func(x float64) float64 {
+		d := (x - center) / bandwidth
+		return math.Exp(-0.5 * d * d)
+	}
+
+	pol := NewPolynomial(bignum.ChebyshevApproximation(kernel, interval))
+
+	// The approximation is defined over interval: map ctIn onto [-1, 1] before evaluating it.
+	scalar, constant := pol.ChangeOfBasis()
+	x, err := eval.MulNew(ctIn, scalar)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = eval.Rescale(x, x); err != nil {
+		return nil, err
+	}
+
+	if err = eval.Add(x, constant, x); err != nil {
+		return nil, err
+	}
+
+	weighted, err := polyEval.Evaluate(x, pol, eval.GetParameters().DefaultScale())
+	if err != nil {
+		return nil, err
+	}
+
+	count = NewCiphertext(*eval.GetParameters(), 1, weighted.Level())
+	if err = eval.InnerSum(weighted, 1, n, count); err != nil {
+		return nil, err
+	}
+
+	// Only slot 0 of the n-slot group holds the true sum, the other n-1 slots hold partial,
+	// meaningless sums (see InnerSum): mask them to zero so that the caller can safely rotate slot 0
+	// into place without spilling garbage into neighboring buckets.
+	mask := make([]float64, count.Slots())
+	mask[0] = 1
+
+	maskPt := NewPlaintext(*eval.GetParameters(), count.Level())
+	maskPt.MetaData = count.MetaData
+	if err = eval.Encode(mask, maskPt); err != nil {
+		return nil, err
+	}
+
+	if err = eval.Mul(count, maskPt, count); err != nil {
+		return nil, err
+	}
+
+	return count, eval.Rescale(count, count)
+}
@@ -0,0 +1,83 @@
+package hefloat_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+	"github.com/tuneinsight/lattigo/v5/he/hefloat"
+	"github.com/tuneinsight/lattigo/v5/ring"
+)
+
+func TestHistogram(t *testing.T) {
+
+	// HistogramNew consumes ceil(log2(degree)) levels per bucket to evaluate the Gaussian kernel
+	// plus 1 level to mask the InnerSum result: use dedicated parameters with enough levels.
+	paramsLiteral := hefloat.ParametersLiteral{
+		LogN:            10,
+		LogQ:            []int{55, 45, 45, 45, 45, 45},
+		LogP:            []int{60},
+		LogDefaultScale: 45,
+	}
+
+	for _, ringType := range []ring.Type{ring.Standard, ring.ConjugateInvariant} {
+
+		paramsLiteral.RingType = ringType
+
+		params, err := hefloat.NewParametersFromLiteral(paramsLiteral)
+		require.NoError(t, err)
+
+		var tc *testContext
+		if tc, err = genTestParams(params); err != nil {
+			t.Fatal(err)
+		}
+
+		const n = 4
+		const degree = 8
+
+		bucketCenters := []float64{-0.5, 0, 0.5}
+		const bandwidth = 0.3
+
+		galEls := hefloat.GaloisElementsForHistogram(params, n, len(bucketCenters))
+
+		evk := rlwe.NewMemEvaluationKeySet(tc.kgen.GenRelinearizationKeyNew(tc.sk), tc.kgen.GenGaloisKeysNew(galEls, tc.sk)...)
+
+		eval := tc.evaluator.WithKey(evk)
+
+		t.Run(GetTestName(params, "Histogram"), func(t *testing.T) {
+
+			raw := []float64{-0.5, -0.45, 0.05, 0.55}
+
+			values := make([]complex128, params.MaxSlots())
+			for i := range raw {
+				values[i] = complex(raw[i], 0)
+			}
+
+			pt := hefloat.NewPlaintext(params, params.MaxLevel())
+			require.NoError(t, tc.encoder.Encode(values, pt))
+
+			ct, err := tc.encryptorSk.EncryptNew(pt)
+			require.NoError(t, err)
+
+			have, err := eval.HistogramNew(ct, bucketCenters, bandwidth, n, degree, [2]float64{-1, 1})
+			require.NoError(t, err)
+
+			kernel := func(x, center float64) float64 {
+				d := (x - center) / bandwidth
+				return math.Exp(-0.5 * d * d)
+			}
+
+			want := make([]complex128, params.MaxSlots())
+			for i, center := range bucketCenters {
+				var sum float64
+				for _, x := range raw {
+					sum += kernel(x, center)
+				}
+				want[i] = complex(sum, 0)
+			}
+
+			hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, want, have, 5, 0, *printPrecisionStats, t)
+		})
+	}
+}
@@ -308,6 +308,65 @@ func (eval InverseEvaluator) GoldschmidtDivisionNew(ct *rlwe.Ciphertext, log2min
 	return a, nil
 }
 
+// InverseToPrecision computes an approximation of 1/x for x encrypted in ct, where x is known to lie
+// within inputRange, and checks upfront that targetBits bits of precision are achievable given ct's
+// current scale, instead of leaving the choice of the number of Goldschmidt iterations -- and the
+// resulting trial-and-error over whether it was enough -- to the caller.
+//
+// GoldschmidtDivisionNew, which this method delegates to, already derives its iteration count
+// automatically from ct.Scale and errors if ct does not have enough remaining levels (and no
+// bootstrapper is configured); InverseToPrecision adds the missing piece: an explicit, checkable
+// target precision and domain, translated into GoldschmidtDivisionNew's log2min parameter.
+//
+// inputRange must lie within (0, 2): GoldschmidtDivisionNew's domain does not extend past it. If x
+// lies outside that range, rescale it into (0, 2) before calling InverseToPrecision and rescale the
+// result back afterwards.
+//
+// The method returns an error if inputRange is invalid, if targetBits exceeds the precision
+// representable at ct's current scale, or if GoldschmidtDivisionNew itself errors.
+func (eval InverseEvaluator) InverseToPrecision(ct *rlwe.Ciphertext, inputRange [2]float64, targetBits float64) (ctInv *rlwe.Ciphertext, err error) {
+
+	min, max := inputRange[0], inputRange[1]
+
+	if min <= 0 || max >= 2 || min > max {
+		return nil, fmt.Errorf("cannot InverseToPrecision: inputRange=%v must satisfy 0 < inputRange[0] <= inputRange[1] < 2", inputRange)
+	}
+
+	// Ceiling imposed by ct's scale on the representable precision, mirroring the "prec" threshold
+	// GoldschmidtDivisionNew derives internally from ct.Scale to decide when to stop iterating.
+	maxBits := math.Log2(ct.Scale.Float64()) - math.Log2(float64(eval.Parameters.N()/2))
+	if targetBits > maxBits {
+		return nil, fmt.Errorf("cannot InverseToPrecision: targetBits=%.1f exceeds the %.1f bits of precision representable at ct's current scale; increase ct.Scale or lower targetBits", targetBits, maxBits)
+	}
+
+	log2min := math.Log2(math.Min(min, 2-max))
+
+	return eval.GoldschmidtDivisionNew(ct, log2min)
+}
+
+// DivNew homomorphically computes num/den, for den with values in the domain [0, 2], by computing the
+// reciprocal of den with GoldschmidtDivisionNew and multiplying the result with num. The rescaling
+// following the multiplication is handled internally.
+// See GoldschmidtDivisionNew for the considerations on the domain of den, the role of log2min and the
+// conditions under which this method returns an error.
+func (eval InverseEvaluator) DivNew(num, den *rlwe.Ciphertext, log2min float64) (ctOut *rlwe.Ciphertext, err error) {
+
+	var denInv *rlwe.Ciphertext
+	if denInv, err = eval.GoldschmidtDivisionNew(den, log2min); err != nil {
+		return nil, fmt.Errorf("cannot DivNew: %w", err)
+	}
+
+	if ctOut, err = eval.MulRelinNew(num, denInv); err != nil {
+		return nil, fmt.Errorf("cannot DivNew: %w", err)
+	}
+
+	if err = eval.Rescale(ctOut, ctOut); err != nil {
+		return nil, fmt.Errorf("cannot DivNew: %w", err)
+	}
+
+	return ctOut, nil
+}
+
 // IntervalNormalization applies a modified version of Algorithm 2 of Efficient Homomorphic Evaluation on Large Intervals (https://eprint.iacr.org/2022/280)
 // to normalize the interval from [-max, max] to [-1, 1]. Also returns the encrypted normalization factor.
 //
@@ -78,6 +78,48 @@ func TestInverse(t *testing.T) {
 			hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, values, ciphertext, 70, 0, *printPrecisionStats, t)
 		})
 
+		t.Run(GetTestName(params, "InverseToPrecision"), func(t *testing.T) {
+
+			inputRange := [2]float64{0.1, 1}
+
+			values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, complex(inputRange[0], 0), complex(inputRange[1], 0), t)
+
+			one := new(big.Float).SetInt64(1)
+			for i := range values {
+				values[i][0].Quo(one, values[i][0])
+			}
+
+			invEval := hefloat.NewInverseEvaluator(params, eval, btp)
+
+			ctOut, err := invEval.InverseToPrecision(ciphertext, inputRange, 20)
+			require.NoError(t, err)
+
+			hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, values, ctOut, 20, 0, *printPrecisionStats, t)
+
+			// An unreasonably high target relative to ciphertext's scale must be rejected upfront,
+			// rather than silently returning an under-precise result.
+			_, err = invEval.InverseToPrecision(ciphertext, inputRange, 1e9)
+			require.Error(t, err)
+		})
+
+		t.Run(GetTestName(params, "DivNew"), func(t *testing.T) {
+
+			valuesNum, _, ctNum := newTestVectors(tc, tc.encryptorSk, complex(min, 0), complex(2-min, 0), t)
+			valuesDen, _, ctDen := newTestVectors(tc, tc.encryptorSk, complex(min, 0), complex(2-min, 0), t)
+
+			want := make([]*big.Float, len(valuesNum))
+			for i := range want {
+				want[i] = new(big.Float).Quo(valuesNum[i][0], valuesDen[i][0])
+			}
+
+			invEval := hefloat.NewInverseEvaluator(params, eval, btp)
+
+			ctOut, err := invEval.DivNew(ctNum, ctDen, logmin)
+			require.NoError(t, err)
+
+			hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, want, ctOut, 70, 0, *printPrecisionStats, t)
+		})
+
 		t.Run(GetTestName(params, "PositiveDomain"), func(t *testing.T) {
 
 			values, _, ct := newTestVectors(tc, enc, complex(0, 0), complex(max, 0), t)
@@ -0,0 +1,145 @@
+package hefloat
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+)
+
+// L2NormalizeNew homomorphically computes x / ||x||_2 for the vector encoded in the first n slots of ctIn,
+// and returns the result in a newly allocated rlwe.Ciphertext. Slots n to Slots-1 of ctIn are assumed to be
+// zero; their contribution to the sum of squares is otherwise undefined.
+//
+// The norm is computed as Square -> InnerSum -> Replicate (sum x_i^2, broadcast to every slot of the
+// first n-slot group), followed by invSqrtIterations Newton iterations y_{k+1} = y_k*(1.5 - 0.5*s*y_k^2)
+// approximating s^{-1/2}, and a final multiplication of ctIn by the result.
+//
+// Newton's iteration for the inverse square root converges quadratically from the starting point y_0 = 1
+// provided that s = sum x_i^2 lies in the open interval (0, 2); callers must pre-scale their vectors so
+// that this holds (e.g. by dividing by a known or estimated upper bound on ||x||_2). invSqrtIterations
+// roughly doubles the number of correct bits at every iteration, mirroring InverseEvaluator.GoldschmidtDivisionNew.
+//
+// This method requires a rlwe.RelinearizationKey and the rlwe.GaloisKeys returned by
+// GaloisElementsForInnerSum and GaloisElementsForReplicate for batch 1 and n.
+func (eval Evaluator) L2NormalizeNew(ctIn *rlwe.Ciphertext, n, invSqrtIterations int) (opOut *rlwe.Ciphertext, err error) {
+
+	if n < 1 || n > ctIn.Slots() {
+		return nil, fmt.Errorf("cannot L2NormalizeNew: n must be in [1, %d], but is %d", ctIn.Slots(), n)
+	}
+
+	if invSqrtIterations < 1 {
+		return nil, fmt.Errorf("cannot L2NormalizeNew: invSqrtIterations must be >= 1, but is %d", invSqrtIterations)
+	}
+
+	// s = sum_{i<n} x_i^2, replicated over slots [0, n).
+	s, err := eval.l2NormSquared(ctIn, n)
+	if err != nil {
+		return nil, fmt.Errorf("cannot L2NormalizeNew: %w", err)
+	}
+
+	// Newton iteration for s^{-1/2}, starting from y_0 = 1: y_1 = 1.5 - 0.5*s requires no multiplication.
+	y, err := eval.MulNew(s, -0.5)
+	if err != nil {
+		return nil, fmt.Errorf("cannot L2NormalizeNew: %w", err)
+	}
+
+	if err = eval.Rescale(y, y); err != nil {
+		return nil, fmt.Errorf("cannot L2NormalizeNew: %w", err)
+	}
+
+	if err = eval.Add(y, 1.5, y); err != nil {
+		return nil, fmt.Errorf("cannot L2NormalizeNew: %w", err)
+	}
+
+	for k := 1; k < invSqrtIterations; k++ {
+		if y, err = eval.invSqrtStep(s, y); err != nil {
+			return nil, fmt.Errorf("cannot L2NormalizeNew: %w", err)
+		}
+	}
+
+	if opOut, err = eval.MulRelinNew(ctIn, y); err != nil {
+		return nil, fmt.Errorf("cannot L2NormalizeNew: %w", err)
+	}
+
+	return opOut, eval.Rescale(opOut, opOut)
+}
+
+// l2NormSquared returns sum_{i<n} x_i^2, broadcast to every slot of the first n-slot group of ctIn.
+func (eval Evaluator) l2NormSquared(ctIn *rlwe.Ciphertext, n int) (sumSq *rlwe.Ciphertext, err error) {
+
+	sq, err := eval.MulRelinNew(ctIn, ctIn)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = eval.Rescale(sq, sq); err != nil {
+		return nil, err
+	}
+
+	sumSq = NewCiphertext(*eval.GetParameters(), 1, sq.Level())
+	if err = eval.InnerSum(sq, 1, n, sumSq); err != nil {
+		return nil, err
+	}
+
+	// Only the leftmost slot of the n-slot group holds the true sum, the other n-1 slots of the
+	// group hold partial, meaningless sums (see InnerSum): mask them to zero so that Replicate, which
+	// requires a zero gap of batchSize*(n-1) slots between repetitions, broadcasts a correct value.
+	mask := make([]float64, sumSq.Slots())
+	mask[0] = 1
+
+	maskPt := NewPlaintext(*eval.GetParameters(), sumSq.Level())
+	maskPt.MetaData = sumSq.MetaData
+	if err = eval.Encode(mask, maskPt); err != nil {
+		return nil, err
+	}
+
+	if err = eval.Mul(sumSq, maskPt, sumSq); err != nil {
+		return nil, err
+	}
+
+	if err = eval.Rescale(sumSq, sumSq); err != nil {
+		return nil, err
+	}
+
+	return sumSq, eval.Replicate(sumSq, 1, n, sumSq)
+}
+
+// invSqrtStep applies one Newton iteration y <- y*(1.5 - 0.5*s*y^2) approximating s^{-1/2}.
+func (eval Evaluator) invSqrtStep(s, y *rlwe.Ciphertext) (yNext *rlwe.Ciphertext, err error) {
+
+	y2, err := eval.MulRelinNew(y, y)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = eval.Rescale(y2, y2); err != nil {
+		return nil, err
+	}
+
+	t, err := eval.MulRelinNew(s, y2)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = eval.Rescale(t, t); err != nil {
+		return nil, err
+	}
+
+	if err = eval.Mul(t, -0.5, t); err != nil {
+		return nil, err
+	}
+
+	if err = eval.Rescale(t, t); err != nil {
+		return nil, err
+	}
+
+	if err = eval.Add(t, 1.5, t); err != nil {
+		return nil, err
+	}
+
+	if yNext, err = eval.MulRelinNew(y, t); err != nil {
+		return nil, err
+	}
+
+	return yNext, eval.Rescale(yNext, yNext)
+}
@@ -0,0 +1,85 @@
+package hefloat_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+	"github.com/tuneinsight/lattigo/v5/he/hefloat"
+	"github.com/tuneinsight/lattigo/v5/ring"
+	"github.com/tuneinsight/lattigo/v5/utils/sampling"
+)
+
+func TestL2Normalize(t *testing.T) {
+
+	// L2NormalizeNew consumes 2 levels for the sum of squares, 1 for the first Newton step and
+	// 4*(invSqrtIterations-1) for the remaining Newton steps, plus 1 for the final product: use
+	// dedicated parameters with enough levels for 2 iterations.
+	paramsLiteral := hefloat.ParametersLiteral{
+		LogN:            10,
+		LogQ:            []int{55, 45, 45, 45, 45, 45, 45, 45, 45, 45},
+		LogP:            []int{60},
+		LogDefaultScale: 45,
+	}
+
+	for _, ringType := range []ring.Type{ring.Standard, ring.ConjugateInvariant} {
+
+		paramsLiteral.RingType = ringType
+
+		params, err := hefloat.NewParametersFromLiteral(paramsLiteral)
+		require.NoError(t, err)
+
+		var tc *testContext
+		if tc, err = genTestParams(params); err != nil {
+			t.Fatal(err)
+		}
+
+		const n = 4
+		const invSqrtIterations = 2
+
+		galEls := append(params.GaloisElementsForInnerSum(1, n), params.GaloisElementsForReplicate(1, n)...)
+
+		evk := rlwe.NewMemEvaluationKeySet(tc.kgen.GenRelinearizationKeyNew(tc.sk), tc.kgen.GenGaloisKeysNew(galEls, tc.sk)...)
+
+		eval := tc.evaluator.WithKey(evk)
+
+		t.Run(GetTestName(params, "L2Normalize"), func(t *testing.T) {
+
+			// Draws a random direction and rescales it so that sum(x_i^2) == target, a value
+			// comfortably inside the (0, 2) convergence domain documented on L2NormalizeNew.
+			const target = 0.7
+
+			raw := make([]float64, n)
+			var rawSumSq float64
+			for i := range raw {
+				raw[i] = sampling.RandFloat64(-1, 1)
+				rawSumSq += raw[i] * raw[i]
+			}
+
+			scale := math.Sqrt(target / rawSumSq)
+
+			values := make([]complex128, params.MaxSlots())
+			for i := range raw {
+				values[i] = complex(raw[i]*scale, 0)
+			}
+
+			pt := hefloat.NewPlaintext(params, params.MaxLevel())
+			require.NoError(t, tc.encoder.Encode(values, pt))
+
+			ct, err := tc.encryptorSk.EncryptNew(pt)
+			require.NoError(t, err)
+
+			have, err := eval.L2NormalizeNew(ct, n, invSqrtIterations)
+			require.NoError(t, err)
+
+			want := make([]complex128, params.MaxSlots())
+			norm := 1 / math.Sqrt(target)
+			for i := range raw {
+				want[i] = complex(real(values[i])*norm, 0)
+			}
+
+			hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, want, have, 7, 0, *printPrecisionStats, t)
+		})
+	}
+}
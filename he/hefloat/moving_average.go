@@ -0,0 +1,67 @@
+package hefloat
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+)
+
+// MovingAverageNew homomorphically computes, for each slot, the average of that slot together with its
+// `window-1` neighbors (i.e. a centered moving average of the given window size) and returns the result
+// in a newly allocated rlwe.Ciphertext.
+//
+// The average is computed with a sequence of rotate-and-add operations followed by a multiplication by the
+// plaintext constant 1/window, and it therefore requires a rlwe.GaloisKey for each of the rotations
+// in [-(window/2), window-1-(window/2)] \ {0} (integer division); no rlwe.RelinearizationKey is used.
+//
+// Because the slots are arranged on a cyclic group, slots close to the boundary of a rotation average with
+// slots wrapped around from the other end of the slot range. MovingAverage consumes one level.
+func (eval Evaluator) MovingAverageNew(ctIn *rlwe.Ciphertext, window int) (opOut *rlwe.Ciphertext, err error) {
+	opOut = NewCiphertext(*eval.GetParameters(), ctIn.Degree(), ctIn.Level())
+	return opOut, eval.MovingAverage(ctIn, window, opOut)
+}
+
+// MovingAverage homomorphically computes, for each slot, the average of that slot together with its
+// `window-1` neighbors (i.e. a centered moving average of the given window size) and writes the result
+// in opOut.
+//
+// The average is computed with a sequence of rotate-and-add operations followed by a multiplication by the
+// plaintext constant 1/window, and it therefore requires a rlwe.GaloisKey for each of the rotations
+// in [-(window/2), window-1-(window/2)] \ {0} (integer division); no rlwe.RelinearizationKey is used.
+//
+// Because the slots are arranged on a cyclic group, slots close to the boundary of a rotation average with
+// slots wrapped around from the other end of the slot range. MovingAverage consumes one level.
+func (eval Evaluator) MovingAverage(ctIn *rlwe.Ciphertext, window int, opOut *rlwe.Ciphertext) (err error) {
+
+	if window < 1 {
+		return fmt.Errorf("cannot MovingAverage: window must be >= 1, but is %d", window)
+	}
+
+	// Offsets of the window neighbors, e.g. window=4 -> [-2, -1, 0, 1].
+	low := -(window / 2)
+	high := window - 1 + low
+
+	acc := ctIn.CopyNew()
+
+	tmp := NewCiphertext(*eval.GetParameters(), ctIn.Degree(), ctIn.Level())
+
+	for k := low; k <= high; k++ {
+		if k == 0 {
+			continue
+		}
+
+		if err = eval.Rotate(ctIn, k, tmp); err != nil {
+			return fmt.Errorf("cannot MovingAverage: %w", err)
+		}
+
+		if err = eval.Add(acc, tmp, acc); err != nil {
+			return fmt.Errorf("cannot MovingAverage: %w", err)
+		}
+	}
+
+	if err = eval.Mul(acc, 1/float64(window), opOut); err != nil {
+		return fmt.Errorf("cannot MovingAverage: %w", err)
+	}
+
+	return eval.Rescale(opOut, opOut)
+}
@@ -0,0 +1,62 @@
+package hefloat_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+	"github.com/tuneinsight/lattigo/v5/he/hefloat"
+	"github.com/tuneinsight/lattigo/v5/utils/bignum"
+)
+
+func testMovingAverage(tc *testContext, t *testing.T) {
+
+	params := tc.params
+
+	t.Run(GetTestName(params, "MovingAverage"), func(t *testing.T) {
+
+		if params.MaxLevel() < 1 {
+			t.Skip("skipping test for params max level < 1")
+		}
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		window := 5
+
+		low := -(window / 2)
+		high := window - 1 + low
+
+		rotations := make([]int, 0, window-1)
+		for k := low; k <= high; k++ {
+			if k != 0 {
+				rotations = append(rotations, k)
+			}
+		}
+
+		evk := rlwe.NewMemEvaluationKeySet(tc.kgen.GenRelinearizationKeyNew(tc.sk), tc.kgen.GenGaloisKeysNew(tc.params.GaloisElements(rotations), tc.sk)...)
+
+		eval := tc.evaluator.WithKey(evk)
+
+		res, err := eval.MovingAverageNew(ciphertext, window)
+		require.NoError(t, err)
+
+		slots := len(values)
+
+		want := make([]*bignum.Complex, slots)
+		for i := range want {
+			want[i] = values[i].Clone()
+			for k := low; k <= high; k++ {
+				if k == 0 {
+					continue
+				}
+				want[i].Add(want[i], values[(i+k+slots)%slots])
+			}
+			nB := new(big.Float).SetFloat64(float64(window))
+			want[i][0].Quo(want[i][0], nB)
+			want[i][1].Quo(want[i][1], nB)
+		}
+
+		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, want, res, 20, 0, *printPrecisionStats, t)
+	})
+}
@@ -44,6 +44,18 @@ func NewPolynomialEvaluator(params Parameters, eval he.Evaluator) *PolynomialEva
 // pol: a *bignum.Polynomial, *Polynomial or *PolynomialVector
 // targetScale: the desired output scale. This value shouldn't differ too much from the original ciphertext scale. It can
 // for example be used to correct small deviations in the ciphertext scale and reset it to the default scale.
+//
+// Unlike an unmanaged sequence of Mul/Rescale calls, the output scale of Evaluate is not a side
+// effect of the polynomial's degree or basis: on success, opOut.Scale is guaranteed to match
+// targetScale, regardless of the input ciphertext's scale or of the polynomial being evaluated, up
+// to the rounding inherent to the encoding of the correction factor applied internally to reach it.
+// This rounding is far below the precision of the scheme itself, but callers that need to compare
+// opOut.Scale against targetScale should do so with rlwe.Scale.InDelta rather than rlwe.Scale.Equal.
+//
+// This guarantee is what makes Evaluate safe to chain: composing several polynomial evaluations
+// (as in an EvalMod-style pipeline) by feeding one call's opOut into the next call's ct always lands
+// each stage on its own requested targetScale, regardless of the degree of either polynomial or of
+// the scale the previous stage happened to produce.
 func (eval PolynomialEvaluator) Evaluate(ct *rlwe.Ciphertext, p interface{}, targetScale rlwe.Scale) (opOut *rlwe.Ciphertext, err error) {
 
 	var phe interface{}
@@ -85,6 +97,22 @@ func (eval PolynomialEvaluator) EvaluateFromPowerBasis(pb he.PowerBasis, p inter
 	return he.EvaluatePolynomial(eval, pb, phe, targetScale, levelsConsumedPerRescaling, &simEvaluator{eval.Parameters, levelsConsumedPerRescaling})
 }
 
+// EvaluateInPlace evaluates a polynomial on the input Ciphertext, as Evaluate, but writes the
+// result back into ct instead of allocating a new Ciphertext, so that ct's backing buffer can
+// be reused across repeated evaluations. p can be given in the monomial or Chebyshev basis,
+// see Evaluate for the full semantics.
+func (eval PolynomialEvaluator) EvaluateInPlace(ct *rlwe.Ciphertext, p interface{}, targetScale rlwe.Scale) (err error) {
+
+	opOut, err := eval.Evaluate(ct, p, targetScale)
+	if err != nil {
+		return err
+	}
+
+	ct.Copy(opOut)
+
+	return nil
+}
+
 // CoefficientGetter is a struct that implements the
 // he.CoefficientGetter[*bignum.Complex] interface.
 type CoefficientGetter struct {
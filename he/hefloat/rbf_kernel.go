@@ -0,0 +1,82 @@
+package hefloat
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+	"github.com/tuneinsight/lattigo/v5/utils/bignum"
+)
+
+// GaloisElementsForRBFKernel returns the list of Galois elements necessary to evaluate RBFKernelNew
+// with parameter n.
+func GaloisElementsForRBFKernel(params Parameters, n int) (galEls []uint64) {
+	return append(params.GaloisElementsForInnerSum(1, n), params.GaloisElementsForReplicate(1, n)...)
+}
+
+// RBFKernelNew homomorphically computes the Gaussian (RBF) kernel exp(-gamma*||x-y||^2) between the
+// vectors encoded in the first n slots of ctX and ctY, and returns the result broadcast over the
+// first n slots of a newly allocated rlwe.Ciphertext. Slots n to Slots-1 of ctX and ctY are assumed
+// to be zero; their contribution to the squared distance is otherwise undefined.
+//
+// The squared distance s = ||x-y||^2 is computed as Sub -> Square -> InnerSum -> Replicate, exactly
+// as l2NormSquared computes sum x_i^2 for L2NormalizeNew, and exp(-gamma*s) is then evaluated by a
+// Chebyshev approximation of degree expDegree over [0, maxSqDist]. ||x-y||^2 must lie within
+// [0, maxSqDist] for every pair (x, y) the caller intends to evaluate, so maxSqDist must be set from
+// a known or estimated upper bound on the largest squared distance between x and y (e.g.
+// derived from bounds on ||x|| and ||y||); values outside that range are not guaranteed to be
+// evaluated correctly, and a larger range requires a higher expDegree to keep the same precision.
+//
+// This method requires a rlwe.RelinearizationKey and the rlwe.GaloisKeys returned by
+// GaloisElementsForRBFKernel for n.
+func (eval Evaluator) RBFKernelNew(ctX, ctY *rlwe.Ciphertext, gamma float64, n, expDegree int, maxSqDist float64) (opOut *rlwe.Ciphertext, err error) {
+
+	if n < 1 || n > ctX.Slots() {
+		return nil, fmt.Errorf("cannot RBFKernelNew: n must be in [1, %d], but is %d", ctX.Slots(), n)
+	}
+
+	diff, err := eval.SubNew(ctX, ctY)
+	if err != nil {
+		return nil, fmt.Errorf("cannot RBFKernelNew: %w", err)
+	}
+
+	sqDist, err := eval.l2NormSquared(diff, n)
+	if err != nil {
+		return nil, fmt.Errorf("cannot RBFKernelNew: %w", err)
+	}
+
+	interval := bignum.Interval{
+		Nodes: expDegree,
+		A:     *new(big.Float),
+		B:     *new(big.Float).SetFloat64(maxSqDist),
+	}
+
+	kernel := func(x float64) float64 {
+		return math.Exp(-gamma * x)
+	}
+
+	pol := NewPolynomial(bignum.ChebyshevApproximation(kernel, interval))
+
+	// The approximation is defined over [0, maxSqDist]: map sqDist onto [-1, 1] before evaluating it.
+	scalar, constant := pol.ChangeOfBasis()
+	if err = eval.Mul(sqDist, scalar, sqDist); err != nil {
+		return nil, fmt.Errorf("cannot RBFKernelNew: %w", err)
+	}
+
+	if err = eval.Rescale(sqDist, sqDist); err != nil {
+		return nil, fmt.Errorf("cannot RBFKernelNew: %w", err)
+	}
+
+	if err = eval.Add(sqDist, constant, sqDist); err != nil {
+		return nil, fmt.Errorf("cannot RBFKernelNew: %w", err)
+	}
+
+	polyEval := NewPolynomialEvaluator(*eval.GetParameters(), eval)
+
+	if opOut, err = polyEval.Evaluate(sqDist, pol, eval.GetParameters().DefaultScale()); err != nil {
+		return nil, fmt.Errorf("cannot RBFKernelNew: %w", err)
+	}
+
+	return opOut, nil
+}
@@ -0,0 +1,89 @@
+package hefloat_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+	"github.com/tuneinsight/lattigo/v5/he/hefloat"
+	"github.com/tuneinsight/lattigo/v5/ring"
+)
+
+func TestRBFKernel(t *testing.T) {
+
+	// RBFKernelNew consumes 2 levels for the squared distance (as l2NormSquared does for
+	// L2NormalizeNew), 1 level to map it onto [-1, 1], plus ceil(log2(expDegree)) levels to evaluate
+	// the exp kernel: use dedicated parameters with enough levels.
+	paramsLiteral := hefloat.ParametersLiteral{
+		LogN:            10,
+		LogQ:            []int{55, 45, 45, 45, 45, 45, 45},
+		LogP:            []int{60},
+		LogDefaultScale: 45,
+	}
+
+	for _, ringType := range []ring.Type{ring.Standard, ring.ConjugateInvariant} {
+
+		paramsLiteral.RingType = ringType
+
+		params, err := hefloat.NewParametersFromLiteral(paramsLiteral)
+		require.NoError(t, err)
+
+		var tc *testContext
+		if tc, err = genTestParams(params); err != nil {
+			t.Fatal(err)
+		}
+
+		const n = 4
+		const expDegree = 8
+		const gamma = 0.5
+		const maxSqDist = 4.0
+
+		galEls := hefloat.GaloisElementsForRBFKernel(params, n)
+
+		evk := rlwe.NewMemEvaluationKeySet(tc.kgen.GenRelinearizationKeyNew(tc.sk), tc.kgen.GenGaloisKeysNew(galEls, tc.sk)...)
+
+		eval := tc.evaluator.WithKey(evk)
+
+		t.Run(GetTestName(params, "RBFKernel"), func(t *testing.T) {
+
+			x := []float64{-0.5, 0.1, 0.3, -0.2}
+			y := []float64{0.2, -0.3, 0.4, 0.1}
+
+			valuesX := make([]complex128, params.MaxSlots())
+			valuesY := make([]complex128, params.MaxSlots())
+			for i := range x {
+				valuesX[i] = complex(x[i], 0)
+				valuesY[i] = complex(y[i], 0)
+			}
+
+			ptX := hefloat.NewPlaintext(params, params.MaxLevel())
+			require.NoError(t, tc.encoder.Encode(valuesX, ptX))
+			ctX, err := tc.encryptorSk.EncryptNew(ptX)
+			require.NoError(t, err)
+
+			ptY := hefloat.NewPlaintext(params, params.MaxLevel())
+			require.NoError(t, tc.encoder.Encode(valuesY, ptY))
+			ctY, err := tc.encryptorSk.EncryptNew(ptY)
+			require.NoError(t, err)
+
+			have, err := eval.RBFKernelNew(ctX, ctY, gamma, n, expDegree, maxSqDist)
+			require.NoError(t, err)
+
+			var sqDist float64
+			for i := range x {
+				d := x[i] - y[i]
+				sqDist += d * d
+			}
+
+			wantKernel := complex(math.Exp(-gamma*sqDist), 0)
+
+			want := make([]complex128, params.MaxSlots())
+			for i := 0; i < n; i++ {
+				want[i] = wantKernel
+			}
+
+			hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, want, have, 5, 0, *printPrecisionStats, t)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package hefloat
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+)
+
+// TopKMask returns an approximate 0/1 mask over the first n slots of ct marking the k slots that
+// hold the largest values, using BitonicSort to find the k-th largest value (the threshold) and
+// ComparisonEvaluator.Step to compare every slot against it. n must be a power of two and at most
+// ct.Slots(), and k must be in [1, n].
+//
+// Use must ensure that:
+//   - ct's values are in the interval [-1, 1] (the same requirement as BitonicSort/CompareAndSwap).
+//
+// The approximation quality depends on how well separated the k-th and (k+1)-th largest values
+// are: Step can only distinguish two values by more than its composite sign polynomial's
+// distinguishing delta (e.g. 2^{-30} for DefaultMinimaxCompositePolynomialForSign), so values
+// closer together than that delta around the threshold may be marked 0.5 instead of 0 or 1, and
+// closely-clustered values near the threshold can cause more or fewer than k slots to end up
+// marked close to 1. The mask is exact (up to scheme/approximation noise) when the k-th and
+// (k+1)-th largest values are well separated relative to that delta.
+//
+// This method requires a rlwe.RelinearizationKey and the rlwe.GaloisKeys needed by BitonicSort
+// (rotations for powers of two up to n/2 and their negations) together with those needed by
+// rlwe.Evaluator.Replicate for batch 1 and n, i.e. GaloisElementsForReplicate(1, n), and the
+// rotation by n-k that brings the threshold to slot 0.
+func (eval ComparisonEvaluator) TopKMask(rotEval Evaluator, ct *rlwe.Ciphertext, n, k int) (mask *rlwe.Ciphertext, err error) {
+
+	if n <= 0 || n&(n-1) != 0 {
+		return nil, fmt.Errorf("cannot TopKMask: n=%d must be a power of two", n)
+	}
+
+	if n > ct.Slots() {
+		return nil, fmt.Errorf("cannot TopKMask: n=%d exceeds ct.Slots()=%d", n, ct.Slots())
+	}
+
+	if k < 1 || k > n {
+		return nil, fmt.Errorf("cannot TopKMask: k=%d must be in [1, %d]", k, n)
+	}
+
+	sorted, err := eval.BitonicSort(rotEval, ct, n)
+	if err != nil {
+		return nil, fmt.Errorf("cannot TopKMask: %w", err)
+	}
+
+	levelsPerRescaling := eval.Parameters.LevelsConsumedPerRescaling()
+
+	if sorted.Level() < levelsPerRescaling {
+		if sorted, err = eval.Bootstrap(sorted); err != nil {
+			return nil, fmt.Errorf("cannot TopKMask: %w", err)
+		}
+	}
+
+	threshold, err := eval.broadcastSlot(rotEval, sorted, n, n-k)
+	if err != nil {
+		return nil, fmt.Errorf("cannot TopKMask: %w", err)
+	}
+
+	op0, op1 := ct, threshold
+	if op0.Level() < op1.Level() {
+		if op0, err = eval.Bootstrap(op0); err != nil {
+			return nil, fmt.Errorf("cannot TopKMask: %w", err)
+		}
+	} else if op1.Level() < op0.Level() {
+		if op1, err = eval.Bootstrap(op1); err != nil {
+			return nil, fmt.Errorf("cannot TopKMask: %w", err)
+		}
+	}
+
+	diff, err := eval.SubNew(op0, op1)
+	if err != nil {
+		return nil, fmt.Errorf("cannot TopKMask: %w", err)
+	}
+
+	if diff.Level() < levelsPerRescaling {
+		if diff, err = eval.Bootstrap(diff); err != nil {
+			return nil, fmt.Errorf("cannot TopKMask: %w", err)
+		}
+	}
+
+	mask, err = eval.Step(diff)
+	if err != nil {
+		return nil, fmt.Errorf("cannot TopKMask: %w", err)
+	}
+
+	return mask, nil
+}
+
+// broadcastSlot isolates slot index of ct's first n-slot group and replicates it across the whole
+// group. Replicate requires the value to replicate to sit at the start of the group, so index is
+// first brought to slot 0 by rotation, following the same rotate-mask-Replicate idiom as
+// l2NormSquared (which has index already at 0, via InnerSum, and so skips the rotation).
+func (eval ComparisonEvaluator) broadcastSlot(rotEval Evaluator, ct *rlwe.Ciphertext, n, index int) (broadcast *rlwe.Ciphertext, err error) {
+
+	params := *rotEval.GetParameters()
+
+	if broadcast, err = rotEval.RotateNew(ct, index); err != nil {
+		return nil, err
+	}
+
+	maskValues := make([]float64, broadcast.Slots())
+	maskValues[0] = 1
+
+	maskPt := NewPlaintext(params, broadcast.Level())
+	maskPt.MetaData = broadcast.MetaData
+	if err = rotEval.Encode(maskValues, maskPt); err != nil {
+		return nil, err
+	}
+
+	if err = eval.Mul(broadcast, maskPt, broadcast); err != nil {
+		return nil, err
+	}
+
+	if err = eval.Rescale(broadcast, broadcast); err != nil {
+		return nil, err
+	}
+
+	return broadcast, rotEval.Replicate(broadcast, 1, n, broadcast)
+}
@@ -0,0 +1,81 @@
+package hefloat
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+	"github.com/tuneinsight/lattigo/v5/utils/bignum"
+)
+
+// transposeDiagonalsIndexList returns the list of non-zero diagonals of the dim x dim matrix transpose
+// permutation, expressed in the diagonal representation documented on LinearTransformationParameters.
+func transposeDiagonalsIndexList(dim int) (indexes []int) {
+	for m := -(dim - 1); m <= dim-1; m++ {
+		indexes = append(indexes, m*(dim-1))
+	}
+	return
+}
+
+// transposeDiagonals returns the non-zero diagonals of the dim x dim matrix transpose permutation,
+// tiled to fill slots entries: a ciphertext packing one or more dim x dim matrices side by side in
+// row-major order is transposed independently, matrix by matrix.
+func transposeDiagonals(dim, slots int) Diagonals[*bignum.Complex] {
+
+	one := bignum.NewComplex().SetPrec(53)
+	one[0].SetInt64(1)
+
+	diagonals := make(Diagonals[*bignum.Complex])
+
+	for m := -(dim - 1); m <= dim-1; m++ {
+
+		diag := make([]*bignum.Complex, slots)
+		for i := range diag {
+			diag[i] = bignum.NewComplex().SetPrec(53)
+		}
+
+		for base := 0; base+dim*dim <= slots; base += dim * dim {
+			for j := 0; j < dim; j++ {
+				i := j + m
+				if i >= 0 && i < dim {
+					diag[base+j*dim+i] = one
+				}
+			}
+		}
+
+		diagonals[m*(dim-1)] = diag
+	}
+
+	return diagonals
+}
+
+// GaloisElementsForTranspose returns the list of Galois elements necessary to evaluate Transpose on a
+// Ciphertext packing one or more dim x dim matrices side by side in row-major order.
+func GaloisElementsForTranspose(params Parameters, dim int) (galEls []uint64) {
+	return GaloisElementsForLinearTransformation(params, LinearTransformationParameters{
+		DiagonalsIndexList:       transposeDiagonalsIndexList(dim),
+		LogDimensions:            params.LogMaxDimensions(),
+		LogBabyStepGianStepRatio: 0,
+	})
+}
+
+// Transpose returns a new Ciphertext computing the transpose of the dim x dim matrix (or matrices, if
+// several are packed side by side along the slots) packed in row-major order in ctIn, using the
+// diagonal method: the permutation is expressed as a LinearTransformation and evaluated with eval.
+func Transpose(eval *LinearTransformationEvaluator, ecd *Encoder, params Parameters, ctIn *rlwe.Ciphertext, dim int) (opOut *rlwe.Ciphertext, err error) {
+
+	ltparams := LinearTransformationParameters{
+		DiagonalsIndexList:       transposeDiagonalsIndexList(dim),
+		Level:                    ctIn.Level(),
+		Scale:                    ctIn.Scale,
+		LogDimensions:            ctIn.LogDimensions,
+		LogBabyStepGianStepRatio: 0,
+	}
+
+	linTransf := NewLinearTransformation(params, ltparams)
+
+	if err = EncodeLinearTransformation[*bignum.Complex](ecd, transposeDiagonals(dim, 1<<ctIn.LogDimensions.Cols), linTransf); err != nil {
+		return nil, fmt.Errorf("cannot Transpose: %w", err)
+	}
+
+	return eval.EvaluateNew(ctIn, linTransf)
+}
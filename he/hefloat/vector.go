@@ -0,0 +1,84 @@
+package hefloat
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+)
+
+// EncryptVector encrypts values under key, automatically chunking it into
+// ceil(len(values)/params.MaxSlots()) Ciphertexts whenever it does not fit in a single one.
+// The last chunk is zero-padded up to params.MaxSlots() if len(values) is not a multiple of
+// it. See DecryptVector for the matching reassembly.
+func EncryptVector(params Parameters, values []complex128, encoder *Encoder, key rlwe.EncryptionKey) (cts []*rlwe.Ciphertext, err error) {
+
+	slots := params.MaxSlots()
+
+	encryptor := NewEncryptor(params, key)
+
+	nbChunks := (len(values) + slots - 1) / slots
+	if nbChunks == 0 {
+		nbChunks = 1
+	}
+
+	cts = make([]*rlwe.Ciphertext, nbChunks)
+
+	for i := range cts {
+
+		chunk := make([]complex128, slots)
+
+		lo := i * slots
+		hi := lo + slots
+		if hi > len(values) {
+			hi = len(values)
+		}
+		copy(chunk, values[lo:hi])
+
+		pt := NewPlaintext(params, params.MaxLevel())
+		if err = encoder.Encode(chunk, pt); err != nil {
+			return nil, fmt.Errorf("cannot EncryptVector: %w", err)
+		}
+
+		if cts[i], err = encryptor.EncryptNew(pt); err != nil {
+			return nil, fmt.Errorf("cannot EncryptVector: %w", err)
+		}
+	}
+
+	return cts, nil
+}
+
+// DecryptVector decrypts cts, a sequence of Ciphertexts produced by EncryptVector, and
+// concatenates their slots back into a single slice of totalLen values, dropping the
+// zero-padding of the last chunk.
+func DecryptVector(params Parameters, cts []*rlwe.Ciphertext, decryptor *rlwe.Decryptor, encoder *Encoder, totalLen int) (values []complex128, err error) {
+
+	slots := params.MaxSlots()
+
+	if len(cts) < (totalLen+slots-1)/slots {
+		return nil, fmt.Errorf("cannot DecryptVector: got %d ciphertexts, need at least %d to cover %d values at %d slots each", len(cts), (totalLen+slots-1)/slots, totalLen, slots)
+	}
+
+	values = make([]complex128, totalLen)
+
+	for i, ct := range cts {
+
+		lo := i * slots
+		if lo >= totalLen {
+			break
+		}
+
+		hi := lo + slots
+		if hi > totalLen {
+			hi = totalLen
+		}
+
+		chunk := make([]complex128, slots)
+		if err = encoder.Decode(decryptor.DecryptNew(ct), chunk); err != nil {
+			return nil, fmt.Errorf("cannot DecryptVector: %w", err)
+		}
+
+		copy(values[lo:hi], chunk[:hi-lo])
+	}
+
+	return values, nil
+}
@@ -0,0 +1,39 @@
+package hefloat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tuneinsight/lattigo/v5/he/hefloat"
+	"github.com/tuneinsight/lattigo/v5/ring"
+)
+
+func testEncryptVector(tc *testContext, t *testing.T) {
+
+	params := tc.params
+
+	t.Run(GetTestName(params, "EncryptVector"), func(t *testing.T) {
+
+		slots := params.MaxSlots()
+		totalLen := slots*2 + slots/2
+
+		values := make([]complex128, totalLen)
+		for i := range values {
+			switch params.RingType() {
+			case ring.Standard:
+				values[i] = complex(float64(i), -float64(i))
+			default: // ring.ConjugateInvariant only supports real values.
+				values[i] = complex(float64(i), 0)
+			}
+		}
+
+		cts, err := hefloat.EncryptVector(params, values, tc.encoder, tc.sk)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(cts))
+
+		have, err := hefloat.DecryptVector(params, cts, tc.decryptor, tc.encoder, totalLen)
+		require.NoError(t, err)
+
+		hefloat.VerifyTestVectors(params, tc.encoder, nil, values, have, params.LogDefaultScale()-10, 0, *printPrecisionStats, t)
+	})
+}
@@ -1,6 +1,8 @@
 package heint
 
 import (
+	"io"
+
 	"github.com/tuneinsight/lattigo/v5/core/rlwe"
 	"github.com/tuneinsight/lattigo/v5/he"
 	"github.com/tuneinsight/lattigo/v5/ring"
@@ -38,6 +40,34 @@ func (lt LinearTransformation) GaloisElements(params rlwe.ParameterProvider) []u
 	return he.LinearTransformation(lt).GaloisElements(params)
 }
 
+// BinarySize returns the serialized size of the object in bytes.
+func (lt LinearTransformation) BinarySize() int {
+	return he.LinearTransformation(lt).BinarySize()
+}
+
+// WriteTo writes the object on an io.Writer. It implements the io.WriterTo
+// interface, and will write exactly object.BinarySize() bytes on w.
+func (lt LinearTransformation) WriteTo(w io.Writer) (n int64, err error) {
+	return he.LinearTransformation(lt).WriteTo(w)
+}
+
+// ReadFrom reads on the object from an io.Writer. It implements the
+// io.ReaderFrom interface.
+func (lt *LinearTransformation) ReadFrom(r io.Reader) (n int64, err error) {
+	return (*he.LinearTransformation)(lt).ReadFrom(r)
+}
+
+// MarshalBinary encodes the object into a binary form on a newly allocated slice of bytes.
+func (lt LinearTransformation) MarshalBinary() (p []byte, err error) {
+	return he.LinearTransformation(lt).MarshalBinary()
+}
+
+// UnmarshalBinary decodes a slice of bytes generated by
+// MarshalBinary or WriteTo on the object.
+func (lt *LinearTransformation) UnmarshalBinary(p []byte) (err error) {
+	return (*he.LinearTransformation)(lt).UnmarshalBinary(p)
+}
+
 // NewLinearTransformation instantiates a new LinearTransformation and is a wrapper of he.LinearTransformation.
 // See he.LinearTransformation for the documentation.
 func NewLinearTransformation(params rlwe.ParameterProvider, lt LinearTransformationParameters) LinearTransformation {
@@ -1,13 +1,16 @@
 package he
 
 import (
+	"bufio"
 	"fmt"
+	"io"
 	"sort"
 
 	"github.com/tuneinsight/lattigo/v5/core/rlwe"
 	"github.com/tuneinsight/lattigo/v5/ring"
 	"github.com/tuneinsight/lattigo/v5/ring/ringqp"
 	"github.com/tuneinsight/lattigo/v5/utils"
+	"github.com/tuneinsight/lattigo/v5/utils/buffer"
 )
 
 // LinearTransformationParameters is a struct storing the parameterization of a
@@ -125,6 +128,184 @@ type LinearTransformation struct {
 	Vec                      map[int]ringqp.Poly
 }
 
+// BinarySize returns the serialized size of the object in bytes.
+func (lt LinearTransformation) BinarySize() (dataLen int) {
+
+	dataLen = lt.MetaData.BinarySize() + 28 // MetaData + LogBabyStepGianStepRatio + N1 + Level + #diagonals
+
+	for _, vec := range lt.Vec {
+		dataLen += 8 + vec.BinarySize()
+	}
+
+	return
+}
+
+// WriteTo writes the object on an io.Writer. It implements the io.WriterTo
+// interface, and will write exactly object.BinarySize() bytes on w.
+//
+// Unless w implements the buffer.Writer interface (see lattigo/utils/buffer/writer.go),
+// it will be wrapped into a bufio.Writer. Since this requires allocations, it
+// is preferable to pass a buffer.Writer directly:
+//
+//   - When writing multiple times to a io.Writer, it is preferable to first wrap the
+//     io.Writer in a pre-allocated bufio.Writer.
+//   - When writing to a pre-allocated var b []byte, it is preferable to pass
+//     buffer.NewBuffer(b) as w (see lattigo/utils/buffer/buffer.go).
+func (lt LinearTransformation) WriteTo(w io.Writer) (n int64, err error) {
+
+	switch w := w.(type) {
+	case buffer.Writer:
+
+		var inc int64
+
+		if inc, err = lt.MetaData.WriteTo(w); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		if inc, err = buffer.WriteAsUint64[int](w, lt.LogBabyStepGianStepRatio); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		if inc, err = buffer.WriteAsUint64[int](w, lt.N1); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		if inc, err = buffer.WriteAsUint64[int](w, lt.Level); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		if inc, err = buffer.WriteAsUint32[int](w, len(lt.Vec)); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		for _, key := range utils.GetSortedKeys(lt.Vec) {
+
+			if inc, err = buffer.WriteAsUint64[int](w, key); err != nil {
+				return n + inc, err
+			}
+
+			n += inc
+
+			vec := lt.Vec[key]
+			if inc, err = vec.WriteTo(w); err != nil {
+				return n + inc, err
+			}
+
+			n += inc
+		}
+
+		return
+
+	default:
+		return lt.WriteTo(bufio.NewWriter(w))
+	}
+}
+
+// ReadFrom reads on the object from an io.Writer. It implements the
+// io.ReaderFrom interface.
+//
+// Unless r implements the buffer.Reader interface (see see lattigo/utils/buffer/reader.go),
+// it will be wrapped into a bufio.Reader. Since this requires allocation, it
+// is preferable to pass a buffer.Reader directly:
+//
+//   - When reading multiple values from a io.Reader, it is preferable to first
+//     first wrap io.Reader in a pre-allocated bufio.Reader.
+//   - When reading from a var b []byte, it is preferable to pass a buffer.NewBuffer(b)
+//     as w (see lattigo/utils/buffer/buffer.go).
+func (lt *LinearTransformation) ReadFrom(r io.Reader) (n int64, err error) {
+
+	switch r := r.(type) {
+	case buffer.Reader:
+
+		var inc int64
+
+		if lt.MetaData == nil {
+			lt.MetaData = &rlwe.MetaData{}
+		}
+
+		if inc, err = lt.MetaData.ReadFrom(r); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		if inc, err = buffer.ReadAsUint64[int](r, &lt.LogBabyStepGianStepRatio); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		if inc, err = buffer.ReadAsUint64[int](r, &lt.N1); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		if inc, err = buffer.ReadAsUint64[int](r, &lt.Level); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		var size int
+		if inc, err = buffer.ReadAsUint32[int](r, &size); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		lt.Vec = make(map[int]ringqp.Poly, size)
+
+		for i := 0; i < size; i++ {
+
+			var key int
+			if inc, err = buffer.ReadAsUint64[int](r, &key); err != nil {
+				return n + inc, err
+			}
+
+			n += inc
+
+			var vec ringqp.Poly
+			if inc, err = vec.ReadFrom(r); err != nil {
+				return n + inc, err
+			}
+
+			n += inc
+
+			lt.Vec[key] = vec
+		}
+
+		return
+
+	default:
+		return lt.ReadFrom(bufio.NewReader(r))
+	}
+}
+
+// MarshalBinary encodes the object into a binary form on a newly allocated slice of bytes.
+func (lt LinearTransformation) MarshalBinary() (p []byte, err error) {
+	buf := buffer.NewBufferSize(lt.BinarySize())
+	_, err = lt.WriteTo(buf)
+	return buf.Bytes(), err
+}
+
+// UnmarshalBinary decodes a slice of bytes generated by
+// MarshalBinary or WriteTo on the object.
+func (lt *LinearTransformation) UnmarshalBinary(p []byte) (err error) {
+	_, err = lt.ReadFrom(buffer.NewBuffer(p))
+	return
+}
+
 // GaloisElements returns the list of Galois elements needed for the evaluation of the linear transformation.
 func (lt LinearTransformation) GaloisElements(params rlwe.ParameterProvider) (galEls []uint64) {
 	return GaloisElementsForLinearTransformation(params, utils.GetKeys(lt.Vec), 1<<lt.LogDimensions.Cols, lt.LogBabyStepGianStepRatio)
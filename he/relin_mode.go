@@ -0,0 +1,98 @@
+package he
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+)
+
+// RelinMode selects when a LazyEvaluator relinearizes the output of a multiplication.
+type RelinMode int
+
+const (
+	// Eager relinearizes after every multiplication, keeping every ciphertext at degree 1
+	// at the cost of one relinearization per multiplication.
+	Eager RelinMode = iota
+	// Lazy defers relinearization, letting a ciphertext's degree grow across several
+	// multiplications. Mul relinearizes automatically only once the degree exceeds MaxDegree
+	// (if MaxDegree > 0); otherwise the caller relinearizes explicitly with Relinearize before
+	// any operation that requires degree 1, such as a further multiplication.
+	Lazy
+)
+
+// LazyEvaluator wraps an Evaluator with a RelinMode, so that a multi-multiplication circuit
+// (e.g. a sum of products) can defer relinearization until the caller asks for it or a
+// configured degree limit is hit, trading a higher ciphertext degree (and thus larger
+// ciphertexts and more noise growth per subsequent operation) for fewer, batched
+// relinearizations.
+type LazyEvaluator struct {
+	Evaluator
+	Mode       RelinMode
+	MaxDegree  int
+	relinCount int
+}
+
+// NewLazyEvaluator instantiates a new LazyEvaluator wrapping eval in the given RelinMode.
+// MaxDegree bounds how far Mul lets a ciphertext's degree grow in Lazy mode before
+// relinearizing it automatically; a MaxDegree <= 0 disables the automatic limit, leaving
+// relinearization entirely up to explicit calls to Relinearize.
+func NewLazyEvaluator(eval Evaluator, mode RelinMode, maxDegree int) *LazyEvaluator {
+	return &LazyEvaluator{Evaluator: eval, Mode: mode, MaxDegree: maxDegree}
+}
+
+// Mul multiplies op0 with op1 and writes the result in opOut, relinearizing it immediately
+// in Eager mode, or in Lazy mode once MaxDegree is exceeded (if MaxDegree > 0); otherwise it
+// leaves opOut at its post-multiplication degree for the caller to relinearize later.
+func (eval *LazyEvaluator) Mul(op0 *rlwe.Ciphertext, op1 rlwe.Operand, opOut *rlwe.Ciphertext) (err error) {
+
+	if err = eval.Evaluator.Mul(op0, op1, opOut); err != nil {
+		return fmt.Errorf("cannot Mul: %w", err)
+	}
+
+	return eval.relinearizeByMode(opOut)
+}
+
+// MulNew multiplies op0 with op1 and returns the result in a new ciphertext, applying the
+// same relinearization policy as Mul.
+func (eval *LazyEvaluator) MulNew(op0 *rlwe.Ciphertext, op1 rlwe.Operand) (opOut *rlwe.Ciphertext, err error) {
+
+	if opOut, err = eval.Evaluator.MulNew(op0, op1); err != nil {
+		return nil, fmt.Errorf("cannot MulNew: %w", err)
+	}
+
+	return opOut, eval.relinearizeByMode(opOut)
+}
+
+// relinearizeByMode applies eval's RelinMode policy to op0 after a multiplication: it
+// relinearizes immediately in Eager mode, or in Lazy mode only once MaxDegree is exceeded
+// (if MaxDegree > 0).
+func (eval *LazyEvaluator) relinearizeByMode(op0 *rlwe.Ciphertext) (err error) {
+	switch eval.Mode {
+	case Eager:
+		return eval.Relinearize(op0)
+	case Lazy:
+		if eval.MaxDegree > 0 && op0.Degree() > eval.MaxDegree {
+			return eval.Relinearize(op0)
+		}
+		return nil
+	default:
+		return fmt.Errorf("cannot relinearize: invalid RelinMode %d", eval.Mode)
+	}
+}
+
+// Relinearize relinearizes op0 in place and records that a relinearization was performed,
+// see RelinearizationCount.
+func (eval *LazyEvaluator) Relinearize(op0 *rlwe.Ciphertext) (err error) {
+	if op0.Degree() <= 1 {
+		return nil
+	}
+	eval.relinCount++
+	return eval.Evaluator.Relinearize(op0, op0)
+}
+
+// RelinearizationCount returns the number of relinearizations this LazyEvaluator has
+// performed so far, whether triggered automatically by Mul or by an explicit call to
+// Relinearize.
+func (eval *LazyEvaluator) RelinearizationCount() int {
+	return eval.relinCount
+}
@@ -0,0 +1,84 @@
+package he_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+	"github.com/tuneinsight/lattigo/v5/he"
+	"github.com/tuneinsight/lattigo/v5/schemes/bgv"
+)
+
+// TestLazyEvaluator checks that, for a small sum-of-products circuit, a LazyEvaluator in
+// he.Lazy mode decrypts to the same result as one in he.Eager mode, while performing fewer
+// relinearizations.
+func TestLazyEvaluator(t *testing.T) {
+
+	params, err := bgv.NewParametersFromLiteral(bgv.ParametersLiteral{
+		LogN:             10,
+		Q:                []uint64{0x3fffffa8001, 0x1000090001, 0x10000c8001, 0x10000f0001, 0xffff00001},
+		P:                []uint64{0x7fffffd8001},
+		PlaintextModulus: 0xffc001,
+	})
+	require.NoError(t, err)
+
+	kgen := bgv.NewKeyGenerator(params)
+	sk := kgen.GenSecretKeyNew()
+
+	encoder := bgv.NewEncoder(params)
+	encryptor := bgv.NewEncryptor(params, sk)
+	decryptor := bgv.NewDecryptor(params, sk)
+
+	evk := rlwe.NewMemEvaluationKeySet(kgen.GenRelinearizationKeyNew(sk))
+
+	encryptInt := func(v uint64) *rlwe.Ciphertext {
+		values := make([]uint64, 1<<params.LogMaxSlots())
+		values[0] = v
+		pt := bgv.NewPlaintext(params, params.MaxLevel())
+		require.NoError(t, encoder.Encode(values, pt))
+		ct, err := encryptor.EncryptNew(pt)
+		require.NoError(t, err)
+		return ct
+	}
+
+	decryptInt := func(ct *rlwe.Ciphertext) uint64 {
+		pt := decryptor.DecryptNew(ct)
+		values := make([]uint64, 1<<params.LogMaxSlots())
+		require.NoError(t, encoder.Decode(pt, values))
+		return values[0]
+	}
+
+	// sum = a0*b0 + a1*b1, a sum of two products, computed identically under both modes.
+	a0, b0, a1, b1 := uint64(3), uint64(5), uint64(7), uint64(11)
+	want := a0*b0 + a1*b1
+
+	// sumOfProducts computes a0*b0 + a1*b1 without explicitly relinearizing the individual
+	// products: in Eager mode MulNew already relinearizes each one internally, while in
+	// Lazy mode both products stay at degree 2 until the single, caller-requested
+	// Relinearize of the final sum.
+	sumOfProducts := func(lazyEval *he.LazyEvaluator) *rlwe.Ciphertext {
+
+		p0, err := lazyEval.MulNew(encryptInt(a0), encryptInt(b0))
+		require.NoError(t, err)
+
+		p1, err := lazyEval.MulNew(encryptInt(a1), encryptInt(b1))
+		require.NoError(t, err)
+
+		sum, err := lazyEval.AddNew(p0, p1)
+		require.NoError(t, err)
+
+		require.NoError(t, lazyEval.Relinearize(sum))
+
+		return sum
+	}
+
+	eagerEval := he.NewLazyEvaluator(bgv.NewEvaluator(params, evk), he.Eager, 0)
+	got := decryptInt(sumOfProducts(eagerEval))
+	require.Equal(t, want, got)
+
+	lazyEval := he.NewLazyEvaluator(bgv.NewEvaluator(params, evk), he.Lazy, 0)
+	got = decryptInt(sumOfProducts(lazyEval))
+	require.Equal(t, want, got)
+
+	require.Less(t, lazyEval.RelinearizationCount(), eagerEval.RelinearizationCount())
+}
@@ -115,6 +115,13 @@ func (cks KeySwitchProtocol) SampleCRP(level int, crs CRS) KeySwitchCRP {
 // ct is the rlwe.Ciphertext to keyswitch. Note that ct.Value[0] is not used by the function and can be nil/zero.
 //
 // Expected noise: ctNoise + encFreshSk + smudging
+//
+// Calling GenShare with skOutput set to a zero rlwe.SecretKey (e.g. rlwe.NewSecretKey(params)) turns
+// this into the share of a party's partial decryption of ct under skInput, smudged by this protocol's
+// noise distribution: KeySwitch then only needs to add ct.Value[0] to the parties' aggregated shares
+// to recover the plaintext, without ever reconstructing skInput. This is the primitive that custom
+// MPC protocols can use to let a combiner reconstruct a plaintext from an additively-shared secret
+// key, each party disclosing nothing beyond its own smudged partial decryption.
 func (cks KeySwitchProtocol) GenShare(skInput, skOutput *rlwe.SecretKey, ct *rlwe.Ciphertext, shareOut *KeySwitchShare) {
 
 	levelQ := utils.Min(shareOut.Value.Level(), ct.Value[1].Level())
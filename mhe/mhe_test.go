@@ -115,6 +115,7 @@ func TestMHE(t *testing.T) {
 							testRelinearizationKeyGenProtocol,
 							testGaloisKeyGenProtocol,
 							testKeySwitchProtocol,
+							testPartialDecryption,
 							testPublicKeySwitchProtocol,
 						} {
 							testSet(tc, levelQ, levelP, bpw2, t)
@@ -165,6 +166,41 @@ func testPublicKeyGenProtocol(tc *testContext, levelQ, levelP, bpw2 int, t *test
 
 		require.GreaterOrEqual(t, math.Log2(math.Sqrt(float64(nbParties))*params.NoiseFreshSK())+1, rlwe.NoisePublicKey(pk, tc.skIdeal, params))
 	})
+
+	t.Run(testString(params, "PublicKeyGen/AggregateAll", levelQ, levelP, bpw2), func(t *testing.T) {
+
+		ckg := make([]PublicKeyGenProtocol, nbParties)
+		for i := range ckg {
+			if i == 0 {
+				ckg[i] = NewPublicKeyGenProtocol(params)
+			} else {
+				ckg[i] = ckg[0].ShallowCopy()
+			}
+		}
+
+		shares := make([]PublicKeyGenShare, nbParties)
+		for i := range shares {
+			shares[i] = ckg[i].AllocateShare()
+		}
+
+		crp := ckg[0].SampleCRP(tc.crs)
+
+		for i := range shares {
+			ckg[i].GenShare(tc.skShares[i], crp, &shares[i])
+		}
+
+		// Manual fold, as done by PublicKeyGen/Protocol above, but starting from a fresh
+		// accumulator so that shares is left untouched for the AggregateAll call below.
+		want := ckg[0].AllocateShare()
+		ckg[0].AggregateShares(shares[0], want, &want)
+		for i := 1; i < nbParties; i++ {
+			ckg[0].AggregateShares(want, shares[i], &want)
+		}
+
+		have := AggregateAll(shares, ckg[0].AggregateShares, ckg[0].AllocateShare)
+
+		require.True(t, params.RingQP().Equal(want.Value, have.Value))
+	})
 }
 
 func testRelinearizationKeyGenProtocol(tc *testContext, levelQ, levelP, bpw2 int, t *testing.T) {
@@ -402,6 +438,66 @@ func testKeySwitchProtocol(tc *testContext, levelQ, levelP, bpw2 int, t *testing
 	})
 }
 
+// testPartialDecryption checks that summing the parties' KeySwitchProtocol shares generated with a
+// zero output key (see GenShare) reconstructs the ciphertext's plaintext, demonstrating the protocol's
+// use as a partial-decryption primitive for custom MPC protocols over an additively-shared secret key.
+func testPartialDecryption(tc *testContext, levelQ, levelP, bpw2 int, t *testing.T) {
+
+	params := tc.params
+
+	t.Run(testString(params, "KeySwitch/PartialDecryption", levelQ, levelP, bpw2), func(t *testing.T) {
+
+		cks := make([]KeySwitchProtocol, nbParties)
+
+		sigmaSmudging := 8 * rlwe.DefaultNoise
+
+		var err error
+		for i := range cks {
+			if i == 0 {
+				cks[i], err = NewKeySwitchProtocol(params, ring.DiscreteGaussian{Sigma: sigmaSmudging, Bound: 6 * sigmaSmudging})
+				require.NoError(t, err)
+			} else {
+				cks[i] = cks[0].ShallowCopy()
+			}
+		}
+
+		zero := rlwe.NewSecretKey(params)
+
+		ringQ := params.RingQ().AtLevel(levelQ)
+
+		message := ringQ.NewPoly()
+		tc.uniformSampler.AtLevel(levelQ).Read(message)
+
+		ct := rlwe.NewCiphertext(params, 1, levelQ)
+		rlwe.NewEncryptor(params, tc.skIdeal).EncryptZero(ct)
+		ringQ.Add(ct.Value[0], message, ct.Value[0])
+
+		shares := make([]KeySwitchShare, nbParties)
+		for i := range shares {
+			shares[i] = cks[i].AllocateShare(ct.Level())
+		}
+
+		for i := range shares {
+			cks[i].GenShare(tc.skShares[i], zero, ct, &shares[i])
+			if i > 0 {
+				cks[0].AggregateShares(shares[0], shares[i], &shares[0])
+			}
+		}
+
+		ctOut := rlwe.NewCiphertext(params, 1, ct.Level())
+		cks[0].KeySwitch(ct, shares[0], ctOut)
+
+		have := ringQ.NewPoly()
+		ringQ.Sub(ctOut.Value[0], message, have)
+
+		if ct.IsNTT {
+			ringQ.INTT(have, have)
+		}
+
+		require.GreaterOrEqual(t, math.Log2(NoiseKeySwitch(params, nbParties, params.NoiseFreshSK(), float64(sigmaSmudging)))+1, ringQ.Log2OfStandardDeviation(have))
+	})
+}
+
 func testPublicKeySwitchProtocol(tc *testContext, levelQ, levelP, bpw2 int, t *testing.T) {
 
 	params := tc.params
@@ -0,0 +1,161 @@
+package mheint
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+	"github.com/tuneinsight/lattigo/v5/he"
+	"github.com/tuneinsight/lattigo/v5/he/heint"
+	"github.com/tuneinsight/lattigo/v5/mhe"
+	"github.com/tuneinsight/lattigo/v5/ring"
+)
+
+// ComparisonProtocol implements a secure two-party comparison: given ciphertexts encrypting
+// each party's value under a collective public key, it homomorphically evaluates the indicator
+// `a < b` and relies on the embedded mhe.PublicKeySwitchProtocol to collectively key-switch only
+// the resulting bit to a single requesting party's public key, so that only the boolean result,
+// and neither a, b nor a - b, is ever revealed in the clear.
+//
+// The comparator is evaluated as an exact BFV/BGV plaintext polynomial over a - b, in place of a
+// bit-decomposition/LUT circuit, which this tree's he/heint package does not provide. Consequently,
+// a and b must both lie in [0, Domain), so that a - b always falls within the 2*Domain-1 residues
+// the comparator polynomial is interpolated over; Domain should be kept small, since the degree of
+// that polynomial, and thus the cost of evaluating it, grows linearly with it.
+type ComparisonProtocol struct {
+	mhe.PublicKeySwitchProtocol
+	evalPoly *heint.PolynomialEvaluator
+	lessThan heint.Polynomial
+}
+
+// NewComparisonProtocol creates a new ComparisonProtocol for comparing values in [0, domain),
+// using eval to homomorphically evaluate the comparator polynomial.
+func NewComparisonProtocol(params heint.Parameters, eval he.Evaluator, domain int, noiseFlooding ring.DistributionParameters) (ComparisonProtocol, error) {
+
+	if domain < 1 {
+		return ComparisonProtocol{}, fmt.Errorf("cannot NewComparisonProtocol: domain must be >= 1, but is %d", domain)
+	}
+
+	if T := params.PlaintextModulus(); uint64(2*domain-1) > T {
+		return ComparisonProtocol{}, fmt.Errorf("cannot NewComparisonProtocol: domain is too large for the plaintext modulus: 2*domain-1 (%d) must be <= T (%d), otherwise distinct interpolation points collide mod T and the comparator silently becomes incorrect", 2*domain-1, T)
+	}
+
+	pcks, err := mhe.NewPublicKeySwitchProtocol(params.Parameters, noiseFlooding)
+	if err != nil {
+		return ComparisonProtocol{}, fmt.Errorf("cannot NewComparisonProtocol: %w", err)
+	}
+
+	lessThan, err := lessThanPolynomial(params.PlaintextModulus(), domain)
+	if err != nil {
+		return ComparisonProtocol{}, fmt.Errorf("cannot NewComparisonProtocol: %w", err)
+	}
+
+	return ComparisonProtocol{
+		PublicKeySwitchProtocol: pcks,
+		evalPoly:                heint.NewPolynomialEvaluator(params, eval, true),
+		lessThan:                lessThan,
+	}, nil
+}
+
+// Compare homomorphically evaluates the indicator `a < b` given ctA and ctB, two ciphertexts
+// respectively encrypting a and b under the same collective public key, both known to lie in
+// [0, Domain). The result is a ciphertext encrypting 1 if a < b and 0 otherwise, which still
+// needs to be collectively key-switched, using the embedded mhe.PublicKeySwitchProtocol, before
+// it can be decrypted.
+func (cmp ComparisonProtocol) Compare(ctA, ctB *rlwe.Ciphertext) (ctOut *rlwe.Ciphertext, err error) {
+
+	diff, err := cmp.evalPoly.SubNew(ctA, ctB)
+	if err != nil {
+		return nil, fmt.Errorf("cannot Compare: %w", err)
+	}
+
+	if ctOut, err = cmp.evalPoly.Evaluate(diff, cmp.lessThan, diff.Scale); err != nil {
+		return nil, fmt.Errorf("cannot Compare: %w", err)
+	}
+
+	return ctOut, nil
+}
+
+// lessThanPolynomial returns the unique polynomial of degree at most 2*domain-2, over Z_T, that
+// maps every residue x - y mod T, for x, y in [0, domain), to 1 if x < y and to 0 otherwise. It
+// is computed by Lagrange interpolation over those 2*domain-1 residues.
+func lessThanPolynomial(T uint64, domain int) (heint.Polynomial, error) {
+
+	mod := new(big.Int).SetUint64(T)
+
+	points := make([]*big.Int, 0, 2*domain-1)
+	isNegative := make([]bool, 0, 2*domain-1)
+	for k := -(domain - 1); k < domain; k++ {
+		x := new(big.Int).Mod(big.NewInt(int64(k)), mod)
+		points = append(points, x)
+		isNegative = append(isNegative, k < 0)
+	}
+
+	coeffs := make([]*big.Int, len(points))
+	for i := range coeffs {
+		coeffs[i] = new(big.Int)
+	}
+
+	for k, xk := range points {
+
+		if !isNegative[k] {
+			continue
+		}
+
+		// basis accumulates L_k(x) = prod_{j != k} (x - x_j), and denom accumulates
+		// prod_{j != k} (x_k - x_j), so that L_k(x)/denom is 1 at x_k and 0 at every other x_j.
+		basis := []*big.Int{big.NewInt(1)}
+		denom := big.NewInt(1)
+
+		for j, xj := range points {
+			if j == k {
+				continue
+			}
+
+			basis = polyMulLinear(basis, xj, mod)
+
+			d := new(big.Int).Sub(xk, xj)
+			d.Mod(d, mod)
+			denom.Mul(denom, d)
+			denom.Mod(denom, mod)
+		}
+
+		denomInv := new(big.Int).ModInverse(denom, mod)
+		if denomInv == nil {
+			return heint.Polynomial{}, fmt.Errorf("cannot interpolate comparator polynomial: %s is not invertible mod %d", denom, T)
+		}
+
+		for i, c := range basis {
+			coeffs[i].Add(coeffs[i], new(big.Int).Mul(c, denomInv))
+			coeffs[i].Mod(coeffs[i], mod)
+		}
+	}
+
+	coeffsU64 := make([]uint64, len(coeffs))
+	for i, c := range coeffs {
+		coeffsU64[i] = c.Uint64()
+	}
+
+	return heint.NewPolynomial(coeffsU64), nil
+}
+
+// polyMulLinear multiplies poly, given as coefficients in increasing degree order, by (x - root)
+// modulo mod, and returns the result.
+func polyMulLinear(poly []*big.Int, root, mod *big.Int) []*big.Int {
+
+	out := make([]*big.Int, len(poly)+1)
+	for i := range out {
+		out[i] = new(big.Int)
+	}
+
+	for i, c := range poly {
+		out[i+1].Add(out[i+1], c)
+		out[i].Sub(out[i], new(big.Int).Mul(c, root))
+	}
+
+	for i := range out {
+		out[i].Mod(out[i], mod)
+	}
+
+	return out
+}
@@ -0,0 +1,89 @@
+package mheint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+	"github.com/tuneinsight/lattigo/v5/he/heint"
+)
+
+func TestComparison(t *testing.T) {
+
+	literal := testInsecure
+	literal.PlaintextModulus = testPlaintextModulus[0]
+
+	params, err := heint.NewParametersFromLiteral(literal)
+	require.NoError(t, err)
+
+	tc, err := gentestContext(2, params)
+	require.NoError(t, err)
+
+	// Comparison relies on a multiplicative polynomial evaluation, so the shared evaluator
+	// needs a relinearization key for the collective secret key. In a real deployment this
+	// key would be produced by mhe.RelinearizationKeyProtocol; tc.sk0 stands in for it here
+	// since this test harness already has it in the clear.
+	rlk := rlwe.NewKeyGenerator(params).GenRelinearizationKeyNew(tc.sk0)
+	evaluator := tc.evaluator.WithKey(rlwe.NewMemEvaluationKeySet(rlk))
+
+	const domain = 8
+
+	cmp, err := NewComparisonProtocol(params, evaluator, domain, params.Xe())
+	require.NoError(t, err)
+
+	encode := func(value uint64) *rlwe.Ciphertext {
+		coeffs := make([]uint64, params.MaxSlots())
+		for i := range coeffs {
+			coeffs[i] = value
+		}
+		pt := heint.NewPlaintext(params, params.MaxLevel())
+		require.NoError(t, tc.encoder.Encode(coeffs, pt))
+		ct, err := tc.encryptorPk0.EncryptNew(pt)
+		require.NoError(t, err)
+		return ct
+	}
+
+	for _, tv := range []struct{ a, b uint64 }{
+		{3, 5},
+		{5, 3},
+		{4, 4},
+		{0, domain - 1},
+		{domain - 1, 0},
+	} {
+
+		ctA, ctB := encode(tv.a), encode(tv.b)
+
+		ctBit, err := cmp.Compare(ctA, ctB)
+		require.NoError(t, err)
+
+		share0 := cmp.AllocateShare(ctBit.Level())
+		share1 := cmp.AllocateShare(ctBit.Level())
+
+		cmp.GenShare(tc.sk0Shards[0], tc.pk0, ctBit, &share0)
+		cmp.GenShare(tc.sk0Shards[1], tc.pk0, ctBit, &share1)
+
+		combined := cmp.AllocateShare(ctBit.Level())
+		require.NoError(t, cmp.AggregateShares(share0, share1, &combined))
+
+		ctOut := heint.NewCiphertext(params, 1, ctBit.Level())
+		cmp.KeySwitch(ctBit, combined, ctOut)
+
+		have := make([]uint64, params.MaxSlots())
+		tc.encoder.Decode(tc.decryptorSk0.DecryptNew(ctOut), have)
+
+		want := uint64(0)
+		if tv.a < tv.b {
+			want = 1
+		}
+
+		require.Equal(t, want, have[0], "a=%d b=%d", tv.a, tv.b)
+	}
+
+	t.Run("DomainTooLargeForPlaintextModulus", func(t *testing.T) {
+		// With T = 257, domain = 130 makes 2*domain-1 = 259 > T: two distinct interpolation
+		// points would collide mod T, so this must be rejected rather than silently producing
+		// a wrong comparator.
+		_, err := NewComparisonProtocol(params, evaluator, 130, params.Xe())
+		require.Error(t, err)
+	})
+}
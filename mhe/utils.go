@@ -6,6 +6,40 @@ import (
 	"github.com/tuneinsight/lattigo/v5/core/rlwe"
 )
 
+// AggregateAll folds shares into a single share by repeatedly applying aggregate, the protocol-specific
+// pairwise combiner (e.g. PublicKeyGenProtocol.AggregateShares). allocate must return the identity share
+// for aggregate (i.e. the zero-valued share produced by the protocol's own AllocateShare), which
+// AggregateAll uses as the accumulator so that the result never aliases any element of shares. It
+// panics if shares is empty.
+//
+// This replaces the repeated
+//
+//	out := protocol.AllocateShare()
+//	for i := range shares {
+//		if i > 0 {
+//			protocol.AggregateShares(out, shares[i], &out)
+//		} else {
+//			protocol.AggregateShares(shares[0], out, &out)
+//		}
+//	}
+//
+// pattern shared by every protocol in this package: each protocol's AggregateShares method already has
+// the (share1, share2 S, shareOut *S) signature that aggregate expects.
+func AggregateAll[S any](shares []S, aggregate func(share1, share2 S, shareOut *S), allocate func() S) S {
+
+	if len(shares) == 0 {
+		panic("cannot AggregateAll: shares must be a non-empty slice")
+	}
+
+	out := allocate()
+
+	for _, share := range shares {
+		aggregate(share, out, &out)
+	}
+
+	return out
+}
+
 // NoiseRelinearizationKey returns the standard deviation of the noise of each individual elements in the collective RelinearizationKey.
 func NoiseRelinearizationKey(params rlwe.Parameters, nbParties int) (std float64) {
 
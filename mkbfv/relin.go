@@ -0,0 +1,355 @@
+package mkbfv
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/ring"
+	"github.com/tuneinsight/lattigo/v5/utils/sampling"
+)
+
+// relinBase and relinLevels define the base-B digit decomposition used by the
+// relinearization gadget: a ring element is decomposed into relinLevels digits, each
+// smaller than relinBase, such that sum_l digit_l * relinBase^l reconstructs the original
+// coefficient mod Q.
+const relinBase = uint64(1 << 8)
+
+// relinLevels returns the number of base-relinBase digits needed to cover Q.
+func relinLevels(Q uint64) int {
+	levels := 0
+	for v := Q; v > 0; v >>= 8 {
+		levels++
+	}
+	return levels
+}
+
+// gadgetKey is a digit-decomposed encryption of a raw ring value v (i.e. without the
+// plaintext scaling Delta applied) under the join of k parties' SecretKeys: for every
+// digit level l, Value[l] is a (k+1)-tuple whose phase is relinBase^l * v.
+type gadgetKey struct {
+	Value [][]ring.Poly
+}
+
+// RelinearizationKey holds, for every ordered pair of parties (i, j) with 1 <= i, j <= k,
+// a gadgetKey encrypting SecretKey_i * SecretKey_j. It is generated once, ahead of any
+// multiplication, and can be reused (or shared/published) across arbitrarily many
+// ciphertext products, see Relinearize.
+type RelinearizationKey struct {
+	Parties int
+	Keys    map[[2]int]*gadgetKey
+}
+
+// GenRelinearizationKeyNew generates the RelinearizationKey for the join of the given
+// SecretKeys. Its cost is O(k^2) gadget encryptions, paid once.
+func GenRelinearizationKeyNew(params Parameters, sks []*SecretKey) (rlk *RelinearizationKey, err error) {
+
+	k := len(sks)
+
+	prng, err := sampling.NewPRNG()
+	if err != nil {
+		return nil, err
+	}
+
+	ringQ := params.RingQ()
+	us := ring.NewUniformSampler(prng, ringQ)
+	gs := ring.NewGaussianSampler(prng, ringQ, ring.DiscreteGaussian{Sigma: 3.2, Bound: 19.2}, false)
+	pool := ring.NewPolyPool(ringQ)
+
+	rlk = &RelinearizationKey{Parties: k, Keys: make(map[[2]int]*gadgetKey, k*k)}
+
+	for i := 1; i <= k; i++ {
+		for j := 1; j <= k; j++ {
+
+			v := pool.Get()
+			mulBarrett(ringQ, sks[i-1].Value, sks[j-1].Value, v)
+
+			rlk.Keys[[2]int{i, j}] = genGadgetKey(ringQ, pool, sks, us, gs, v)
+			pool.Put(v)
+		}
+	}
+
+	return rlk, nil
+}
+
+// genGadgetKey builds a gadgetKey encrypting the raw ring value v under sks. pool, if non-nil, is
+// used to source genGadgetKey's short-lived scratch polynomials instead of allocating them fresh,
+// which matters on the hot path of RelinearizationOnTheFly where genGadgetKey is called once per
+// (i, j) pair of every relinearization.
+func genGadgetKey(ringQ *ring.Ring, pool *ring.PolyPool, sks []*SecretKey, us *ring.UniformSampler, gs *ring.GaussianSampler, v ring.Poly) *gadgetKey {
+
+	k := len(sks)
+	L := relinLevels(ringQ.SubRings[0].Modulus)
+
+	gk := &gadgetKey{Value: make([][]ring.Poly, L)}
+
+	scaled := getPoly(ringQ, pool)
+	defer putPoly(pool, scaled)
+	scaled.Copy(v)
+
+	tmp := getPoly(ringQ, pool)
+	defer putPoly(pool, tmp)
+
+	for l := 0; l < L; l++ {
+
+		// row is stored into gk.Value and outlives this call, so it is always freshly allocated.
+		row := make([]ring.Poly, k+1)
+		for i := range row {
+			row[i] = ringQ.NewPoly()
+		}
+
+		phase := getPoly(ringQ, pool)
+		phase.Copy(scaled)
+
+		for i := 1; i <= k; i++ {
+			us.Read(row[i])
+			mulBarrett(ringQ, row[i], sks[i-1].Value, tmp)
+			ringQ.Sub(phase, tmp, phase)
+		}
+
+		e := getPoly(ringQ, pool)
+		gs.Read(e)
+		ringQ.Add(phase, e, row[0])
+		putPoly(pool, phase)
+		putPoly(pool, e)
+
+		gk.Value[l] = row
+
+		// scaled *= relinBase, ready for the next digit level.
+		ringQ.MulScalar(scaled, relinBase, scaled)
+	}
+
+	return gk
+}
+
+// decompose splits each coefficient of v into its relinLevels base-relinBase digits.
+func decompose(ringQ *ring.Ring, v ring.Poly) []ring.Poly {
+
+	Q := ringQ.SubRings[0].Modulus
+	L := relinLevels(Q)
+
+	digits := make([]ring.Poly, L)
+	for l := range digits {
+		digits[l] = ringQ.NewPoly()
+	}
+
+	for n, c := range v.Coeffs[0] {
+		for l := 0; l < L; l++ {
+			digits[l].Coeffs[0][n] = c % relinBase
+			c /= relinBase
+		}
+	}
+
+	return digits
+}
+
+// applyGadgetKey accumulates gk's contribution, weighted by the base-relinBase digit
+// decomposition of d, into the (k+1)-tuple acc. pool, if non-nil, sources applyGadgetKey's
+// short-lived accumulator instead of allocating it fresh.
+func applyGadgetKey(ringQ *ring.Ring, pool *ring.PolyPool, gk *gadgetKey, d ring.Poly, acc []ring.Poly) {
+
+	digits := decompose(ringQ, d)
+
+	tmp := getPoly(ringQ, pool)
+	defer putPoly(pool, tmp)
+	for l, digit := range digits {
+		for m, row := range gk.Value[l] {
+			mulBarrett(ringQ, digit, row, tmp)
+			ringQ.Add(acc[m], tmp, acc[m])
+		}
+	}
+}
+
+// getPoly returns a scratch polynomial from pool, or a freshly allocated one if pool is nil.
+func getPoly(ringQ *ring.Ring, pool *ring.PolyPool) ring.Poly {
+	if pool == nil {
+		return ringQ.NewPoly()
+	}
+	return pool.Get()
+}
+
+// putPoly returns pol to pool, if pool is non-nil.
+func putPoly(pool *ring.PolyPool, pol ring.Poly) {
+	if pool != nil {
+		pool.Put(pol)
+	}
+}
+
+// centered returns p's coefficients as signed integers in (-Q/2, Q/2], rather than the
+// unsigned [0, Q) representatives ring.Poly stores them as.
+func centered(p ring.Poly, Q uint64) []int64 {
+	c := make([]int64, len(p.Coeffs[0]))
+	for i, v := range p.Coeffs[0] {
+		if v > Q/2 {
+			c[i] = int64(v) - int64(Q)
+		} else {
+			c[i] = int64(v)
+		}
+	}
+	return c
+}
+
+// negacyclicConvolve computes the exact (unreduced) coefficients of a*b mod (X^N+1), over
+// the integers rather than mod Q: this is what RLWE multiplication requires before any
+// modular reduction, since the signal carried by a BFV ciphertext product overflows Q
+// before it is rescaled back down by T/Q, see tensor.
+func negacyclicConvolve(a, b []int64) []int64 {
+	N := len(a)
+	c := make([]int64, N)
+	for i, ai := range a {
+		if ai == 0 {
+			continue
+		}
+		for j, bj := range b {
+			k := i + j
+			if k < N {
+				c[k] += ai * bj
+			} else {
+				c[k-N] -= ai * bj
+			}
+		}
+	}
+	return c
+}
+
+// tensor computes the raw (k+1)x(k+1) cross terms of ct0 and ct1, scaled down by the
+// BFV plaintext modulus (i.e. each term is round(T/Q * ct0.Value[i] * ct1.Value[j])), and
+// reduced back to ring.Poly representatives mod Q.
+//
+// The scale-down is performed over exact (unreduced) integer convolutions rather than via
+// ring arithmetic mod Q, because the product of two ciphertext polynomials carries a signal
+// on the order of Delta^2 ~ (Q/T)^2, which is larger than Q and would be destroyed by a
+// modular reduction performed before the T/Q rescale.
+func tensor(params Parameters, ct0, ct1 *Ciphertext) [][]ring.Poly {
+
+	ringQ := params.RingQ()
+	k := ct0.Parties()
+	T := params.T
+	Q := params.Q()
+
+	d := make([][]ring.Poly, k+1)
+	for i := range d {
+		d[i] = make([]ring.Poly, k+1)
+		for j := range d[i] {
+
+			raw := negacyclicConvolve(centered(ct0.Value[i], Q), centered(ct1.Value[j], Q))
+
+			d[i][j] = ringQ.NewPoly()
+			for n, c := range raw {
+				d[i][j].Coeffs[0][n] = centeredDivRoundMod(c, int64(T), Q)
+			}
+		}
+	}
+
+	return d
+}
+
+// centeredDivRoundMod returns round(c*num/Q) reduced into [0, Q).
+func centeredDivRoundMod(c, num int64, Q uint64) uint64 {
+
+	neg := c < 0
+	if neg {
+		c = -c
+	}
+
+	q := divRound(uint64(c), uint64(num), Q)
+
+	if neg {
+		return Q - q%Q
+	}
+
+	return q % Q
+}
+
+// RelinearizationOnTheFly homomorphically multiplies ct0 and ct1 and relinearizes the
+// result back down to a size-(k+1) Ciphertext, deriving the key material it needs for the
+// (k+1-1)x(k+1-1) = k^2 cross terms directly from sks rather than from a precomputed
+// RelinearizationKey. It therefore needs no prior key-generation round, at the cost of
+// redoing the O(k^2) gadget encryptions on every call, see Relinearize for the
+// precomputed-key alternative.
+func RelinearizationOnTheFly(params Parameters, ct0, ct1 *Ciphertext, sks []*SecretKey) (ctOut *Ciphertext, err error) {
+
+	k := ct0.Parties()
+
+	if k != ct1.Parties() || k != len(sks) {
+		return nil, fmt.Errorf("cannot RelinearizationOnTheFly: ciphertexts and secret keys must all be for the same %d parties", k)
+	}
+
+	ringQ := params.RingQ()
+	d := tensor(params, ct0, ct1)
+
+	value := make([]ring.Poly, k+1)
+	for i := range value {
+		value[i] = ringQ.NewPoly()
+	}
+
+	// Degree-0 and degree-1 terms (involving the constant "s_0 = 1" slot) need no
+	// relinearization and are added directly into the output.
+	for i := 0; i <= k; i++ {
+		ringQ.Add(value[i], d[0][i], value[i])
+		if i > 0 {
+			ringQ.Add(value[i], d[i][0], value[i])
+		}
+	}
+
+	prng, err := sampling.NewPRNG()
+	if err != nil {
+		return nil, err
+	}
+	us := ring.NewUniformSampler(prng, ringQ)
+	gs := ring.NewGaussianSampler(prng, ringQ, ring.DiscreteGaussian{Sigma: 3.2, Bound: 19.2}, false)
+	pool := ring.NewPolyPool(ringQ)
+
+	// Degree-2 cross terms: every pair (i, j) with 1 <= i, j <= k must be included, or the
+	// relinearized ciphertext silently drops the contribution of the last party.
+	for i := 1; i <= k; i++ {
+		for j := 1; j <= k; j++ {
+
+			v := pool.Get()
+			mulBarrett(ringQ, sks[i-1].Value, sks[j-1].Value, v)
+
+			gk := genGadgetKey(ringQ, pool, sks, us, gs, v)
+			pool.Put(v)
+			applyGadgetKey(ringQ, pool, gk, d[i][j], value)
+		}
+	}
+
+	return &Ciphertext{Value: value}, nil
+}
+
+// Relinearize homomorphically multiplies ct0 and ct1 and relinearizes the result back down
+// to a size-(k+1) Ciphertext using a RelinearizationKey generated ahead of time by
+// GenRelinearizationKeyNew. Unlike RelinearizationOnTheFly, it performs no gadget
+// key-generation of its own and only needs rlk's O(k^2) precomputed gadget keys,
+// making it the cheaper choice whenever the same key is reused across many
+// multiplications.
+func Relinearize(params Parameters, ct0, ct1 *Ciphertext, rlk *RelinearizationKey) (ctOut *Ciphertext, err error) {
+
+	k := ct0.Parties()
+
+	if k != ct1.Parties() || k != rlk.Parties {
+		return nil, fmt.Errorf("cannot Relinearize: ciphertexts and relinearization key must all be for the same %d parties", k)
+	}
+
+	ringQ := params.RingQ()
+	d := tensor(params, ct0, ct1)
+	pool := ring.NewPolyPool(ringQ)
+
+	value := make([]ring.Poly, k+1)
+	for i := range value {
+		value[i] = ringQ.NewPoly()
+	}
+
+	for i := 0; i <= k; i++ {
+		ringQ.Add(value[i], d[0][i], value[i])
+		if i > 0 {
+			ringQ.Add(value[i], d[i][0], value[i])
+		}
+	}
+
+	for i := 1; i <= k; i++ {
+		for j := 1; j <= k; j++ {
+			applyGadgetKey(ringQ, pool, rlk.Keys[[2]int{i, j}], d[i][j], value)
+		}
+	}
+
+	return &Ciphertext{Value: value}, nil
+}
@@ -0,0 +1,116 @@
+package mkbfv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tuneinsight/lattigo/v5/ring"
+)
+
+func testParameters(t require.TestingT) (params Parameters, kgen *KeyGenerator) {
+
+	params, err := NewParameters(4, 3, 97, 30)
+	require.NoError(t, err)
+
+	kgen, err = NewKeyGenerator(params)
+	require.NoError(t, err)
+
+	return
+}
+
+func newTestPlaintext(params Parameters, value uint64) (pt ring.Poly) {
+	pt = params.RingQ().NewPoly()
+	pt.Coeffs[0][0] = value
+	return
+}
+
+// TestRelinearization checks that, for k=3 parties, both relinearization strategies
+// recover the expected plaintext product after a homomorphic multiplication.
+func TestRelinearization(t *testing.T) {
+
+	params, kgen := testParameters(t)
+
+	sks := make([]*SecretKey, params.Parties)
+	for i := range sks {
+		sks[i] = kgen.GenSecretKeyNew()
+	}
+
+	enc, err := NewEncryptor(params, sks)
+	require.NoError(t, err)
+
+	dec := NewDecryptor(params, sks)
+
+	a, b := uint64(5), uint64(7)
+
+	ct0, err := enc.EncryptNew(newTestPlaintext(params, a))
+	require.NoError(t, err)
+
+	ct1, err := enc.EncryptNew(newTestPlaintext(params, b))
+	require.NoError(t, err)
+
+	want := (a * b) % params.T
+
+	t.Run("OnTheFly", func(t *testing.T) {
+		ctOut, err := RelinearizationOnTheFly(params, ct0, ct1, sks)
+		require.NoError(t, err)
+
+		pt, err := dec.DecryptNew(ctOut)
+		require.NoError(t, err)
+		require.Equal(t, want, pt.Coeffs[0][0])
+	})
+
+	t.Run("SharedKey", func(t *testing.T) {
+		rlk, err := GenRelinearizationKeyNew(params, sks)
+		require.NoError(t, err)
+
+		ctOut, err := Relinearize(params, ct0, ct1, rlk)
+		require.NoError(t, err)
+
+		pt, err := dec.DecryptNew(ctOut)
+		require.NoError(t, err)
+		require.Equal(t, want, pt.Coeffs[0][0])
+	})
+}
+
+// BenchmarkRelinearization compares the per-multiplication cost of deriving relinearization
+// key material on the fly against reusing a RelinearizationKey generated ahead of time.
+func BenchmarkRelinearization(b *testing.B) {
+
+	params, kgen := testParameters(b)
+
+	sks := make([]*SecretKey, params.Parties)
+	for i := range sks {
+		sks[i] = kgen.GenSecretKeyNew()
+	}
+
+	enc, err := NewEncryptor(params, sks)
+	require.NoError(b, err)
+
+	ct0, err := enc.EncryptNew(newTestPlaintext(params, 5))
+	require.NoError(b, err)
+
+	ct1, err := enc.EncryptNew(newTestPlaintext(params, 7))
+	require.NoError(b, err)
+
+	b.Run("OnTheFly", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := RelinearizationOnTheFly(params, ct0, ct1, sks); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("SharedKey", func(b *testing.B) {
+		rlk, err := GenRelinearizationKeyNew(params, sks)
+		require.NoError(b, err)
+
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := Relinearize(params, ct0, ct1, rlk); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
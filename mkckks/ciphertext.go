@@ -0,0 +1,131 @@
+package mkckks
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/ring"
+	"github.com/tuneinsight/lattigo/v5/utils/sampling"
+)
+
+// Ciphertext is an mkckks ciphertext encrypted under the join of k parties' SecretKeys, see
+// mkbfv.Ciphertext. Its plaintext value is a single real number, encoded as
+// round(Scale*value) and carried in the constant coefficient of the decryption phase
+// Value[0] + sum_i Value[i]*SecretKey_i, mod Q.
+type Ciphertext struct {
+	Value []ring.Poly
+	Scale float64
+}
+
+// Parties returns the number of parties a Ciphertext is encrypted under.
+func (ct *Ciphertext) Parties() int {
+	return len(ct.Value) - 1
+}
+
+// Encryptor encrypts real values under the join of a fixed set of parties' SecretKeys,
+// following the same trusted-dealer model as mkbfv.Encryptor.
+type Encryptor struct {
+	params Parameters
+	sks    []*SecretKey
+	us     *ring.UniformSampler
+	gs     *ring.GaussianSampler
+}
+
+// NewEncryptor instantiates a new Encryptor for the join of the given SecretKeys.
+func NewEncryptor(params Parameters, sks []*SecretKey) (enc *Encryptor, err error) {
+
+	prng, err := sampling.NewPRNG()
+	if err != nil {
+		return nil, err
+	}
+
+	gs := ring.NewGaussianSampler(prng, params.RingQ(), ring.DiscreteGaussian{Sigma: 3.2, Bound: 19.2}, false)
+
+	return &Encryptor{
+		params: params,
+		sks:    sks,
+		us:     ring.NewUniformSampler(prng, params.RingQ()),
+		gs:     gs,
+	}, nil
+}
+
+// EncryptNew encrypts value, scaled by scale, into a new Ciphertext under the join of the
+// Encryptor's SecretKeys.
+func (enc *Encryptor) EncryptNew(value float64, scale float64) (ct *Ciphertext, err error) {
+
+	ringQ := enc.params.RingQ()
+	Q := enc.params.Q()
+	k := len(enc.sks)
+
+	value2 := make([]ring.Poly, k+1)
+	for i := range value2 {
+		value2[i] = ringQ.NewPoly()
+	}
+
+	phase := ringQ.NewPoly()
+	phase.Coeffs[0][0] = encodeCoeff(value*scale, Q)
+
+	tmp := ringQ.NewPoly()
+	for i := 1; i <= k; i++ {
+		enc.us.Read(value2[i])
+		mulBarrett(ringQ, value2[i], enc.sks[i-1].Value, tmp)
+		ringQ.Sub(phase, tmp, phase)
+	}
+
+	e := ringQ.NewPoly()
+	enc.gs.Read(e)
+	ringQ.Add(phase, e, value2[0])
+
+	return &Ciphertext{Value: value2, Scale: scale}, nil
+}
+
+// Decryptor jointly decrypts Ciphertexts given every party's SecretKey, following the same
+// trusted-dealer model as mkbfv.Decryptor.
+type Decryptor struct {
+	params Parameters
+	sks    []*SecretKey
+}
+
+// NewDecryptor instantiates a new Decryptor for the join of the given SecretKeys.
+func NewDecryptor(params Parameters, sks []*SecretKey) *Decryptor {
+	return &Decryptor{params: params, sks: sks}
+}
+
+// DecryptNew decrypts ct and returns the resulting real value.
+func (dec *Decryptor) DecryptNew(ct *Ciphertext) (value float64, err error) {
+
+	if ct.Parties() != len(dec.sks) {
+		return 0, fmt.Errorf("cannot DecryptNew: ciphertext is encrypted for %d parties, but Decryptor holds %d keys", ct.Parties(), len(dec.sks))
+	}
+
+	ringQ := dec.params.RingQ()
+
+	phase := *ct.Value[0].CopyNew()
+	tmp := ringQ.NewPoly()
+	for i, sk := range dec.sks {
+		mulBarrett(ringQ, ct.Value[i+1], sk.Value, tmp)
+		ringQ.Add(phase, tmp, phase)
+	}
+
+	return decodeCoeff(phase.Coeffs[0][0], dec.params.Q()) / ct.Scale, nil
+}
+
+// encodeCoeff rounds x and reduces it into [0, Q).
+func encodeCoeff(x float64, Q uint64) uint64 {
+	r := int64(x + 0.5)
+	if x < 0 {
+		r = int64(x - 0.5)
+	}
+	if r < 0 {
+		return Q - uint64(-r)%Q
+	}
+	return uint64(r) % Q
+}
+
+// decodeCoeff interprets c as a centered representative in (-Q/2, Q/2] and returns it as a
+// float64.
+func decodeCoeff(c, Q uint64) float64 {
+	if c > Q/2 {
+		return float64(c) - float64(Q)
+	}
+	return float64(c)
+}
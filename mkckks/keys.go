@@ -0,0 +1,37 @@
+package mkckks
+
+import (
+	"github.com/tuneinsight/lattigo/v5/ring"
+	"github.com/tuneinsight/lattigo/v5/utils/sampling"
+)
+
+// SecretKey is the secret key of a single party in an mkckks instance, see mkbfv.SecretKey.
+type SecretKey struct {
+	Value ring.Poly
+}
+
+// KeyGenerator generates SecretKeys for a given set of Parameters.
+type KeyGenerator struct {
+	ts *ring.TernarySampler
+}
+
+// NewKeyGenerator instantiates a new KeyGenerator for the given Parameters.
+func NewKeyGenerator(params Parameters) (kgen *KeyGenerator, err error) {
+
+	prng, err := sampling.NewPRNG()
+	if err != nil {
+		return nil, err
+	}
+
+	ts, err := ring.NewTernarySampler(prng, params.RingQ(), ring.Ternary{P: 1 / 3.0}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyGenerator{ts: ts}, nil
+}
+
+// GenSecretKeyNew samples a new ternary SecretKey for a single party.
+func (kgen *KeyGenerator) GenSecretKeyNew() *SecretKey {
+	return &SecretKey{Value: kgen.ts.ReadNew()}
+}
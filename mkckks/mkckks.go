@@ -0,0 +1,74 @@
+// Package mkckks is the CKKS (approximate-arithmetic) counterpart of mkbfv: a minimal,
+// reference multi-key scheme in which k independent parties each hold their own secret key
+// and ciphertexts grow to size k+1 accordingly, reusing mkbfv's gadget-based relinearization
+// approach.
+//
+// It inherits every simplification of mkbfv (a single NTT-friendly modulus, no RNS
+// decomposition, a trusted dealer for key generation) and adds one more: it encodes a
+// single real value per ciphertext, scaled by a floating-point Scale as in the rest of
+// Lattigo's CKKS implementations, rather than packing a vector of slots. It is meant as a
+// starting point for prototyping, not as a drop-in replacement for schemes/ckks.
+//
+// Like mkbfv, this package is an unreviewed new feature rather than a hardened
+// implementation: its noise parameters are inherited from mkbfv's fixed, test-only constants
+// and are not tied to any reviewed security-level parameter set.
+package mkckks
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/ring"
+)
+
+// Parameters defines the ring degree and ciphertext modulus of an mkckks instance, together
+// with the number of parties it is instantiated for.
+type Parameters struct {
+	Parties int
+	ringQ   *ring.Ring
+}
+
+// NewParameters instantiates a new set of mkckks Parameters for the given ring degree (a
+// power of two), number of parties, and ciphertext modulus bit-size.
+func NewParameters(logN int, parties int, qBitSize int) (params Parameters, err error) {
+
+	if parties < 1 {
+		return Parameters{}, fmt.Errorf("cannot NewParameters: parties must be >= 1, but is %d", parties)
+	}
+
+	N := 1 << logN
+
+	gen := ring.NewNTTFriendlyPrimesGenerator(uint64(qBitSize), uint64(2*N))
+
+	Q, err := gen.NextAlternatingPrime()
+	if err != nil {
+		return Parameters{}, fmt.Errorf("cannot NewParameters: %w", err)
+	}
+
+	ringQ, err := ring.NewRing(N, []uint64{Q})
+	if err != nil {
+		return Parameters{}, fmt.Errorf("cannot NewParameters: %w", err)
+	}
+
+	return Parameters{Parties: parties, ringQ: ringQ}, nil
+}
+
+// RingQ returns the ciphertext ring of the parameters.
+func (p Parameters) RingQ() *ring.Ring {
+	return p.ringQ
+}
+
+// Q returns the ciphertext modulus.
+func (p Parameters) Q() uint64 {
+	return p.ringQ.SubRings[0].Modulus
+}
+
+// mulBarrett multiplies p1 by p2 in the ring NTT domain, writing the coefficient-domain
+// result into p3. p1 and p2 are left unmodified.
+func mulBarrett(ringQ *ring.Ring, p1, p2, p3 ring.Poly) {
+	a := ringQ.NewPoly()
+	b := ringQ.NewPoly()
+	ringQ.NTT(p1, a)
+	ringQ.NTT(p2, b)
+	ringQ.MulCoeffsBarrett(a, b, a)
+	ringQ.INTT(a, p3)
+}
@@ -0,0 +1,214 @@
+package mkckks
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/ring"
+	"github.com/tuneinsight/lattigo/v5/utils/sampling"
+)
+
+// relinBase and relinLevels mirror mkbfv's gadget digit decomposition.
+const relinBase = uint64(1 << 8)
+
+func relinLevels(Q uint64) int {
+	levels := 0
+	for v := Q; v > 0; v >>= 8 {
+		levels++
+	}
+	return levels
+}
+
+// gadgetKey is a digit-decomposed encryption of a raw ring value v under the join of k
+// parties' SecretKeys, see mkbfv's gadgetKey.
+type gadgetKey struct {
+	Value [][]ring.Poly
+}
+
+// RelinearizationKey holds, for every ordered pair of parties (i, j) with 1 <= i, j <= k, a
+// gadgetKey encrypting SecretKey_i * SecretKey_j, see mkbfv.RelinearizationKey.
+type RelinearizationKey struct {
+	Parties int
+	Keys    map[[2]int]*gadgetKey
+}
+
+// GenRelinearizationKeyNew generates the RelinearizationKey for the join of the given
+// SecretKeys. Its cost is O(k^2) gadget encryptions, paid once.
+func GenRelinearizationKeyNew(params Parameters, sks []*SecretKey) (rlk *RelinearizationKey, err error) {
+
+	k := len(sks)
+
+	prng, err := sampling.NewPRNG()
+	if err != nil {
+		return nil, err
+	}
+
+	ringQ := params.RingQ()
+	us := ring.NewUniformSampler(prng, ringQ)
+	gs := ring.NewGaussianSampler(prng, ringQ, ring.DiscreteGaussian{Sigma: 3.2, Bound: 19.2}, false)
+
+	rlk = &RelinearizationKey{Parties: k, Keys: make(map[[2]int]*gadgetKey, k*k)}
+
+	for i := 1; i <= k; i++ {
+		for j := 1; j <= k; j++ {
+
+			v := ringQ.NewPoly()
+			mulBarrett(ringQ, sks[i-1].Value, sks[j-1].Value, v)
+
+			rlk.Keys[[2]int{i, j}] = genGadgetKey(ringQ, sks, us, gs, v)
+		}
+	}
+
+	return rlk, nil
+}
+
+func genGadgetKey(ringQ *ring.Ring, sks []*SecretKey, us *ring.UniformSampler, gs *ring.GaussianSampler, v ring.Poly) *gadgetKey {
+
+	k := len(sks)
+	L := relinLevels(ringQ.SubRings[0].Modulus)
+
+	gk := &gadgetKey{Value: make([][]ring.Poly, L)}
+
+	scaled := ringQ.NewPoly()
+	scaled.Copy(v)
+
+	tmp := ringQ.NewPoly()
+
+	for l := 0; l < L; l++ {
+
+		row := make([]ring.Poly, k+1)
+		for i := range row {
+			row[i] = ringQ.NewPoly()
+		}
+
+		phase := ringQ.NewPoly()
+		phase.Copy(scaled)
+
+		for i := 1; i <= k; i++ {
+			us.Read(row[i])
+			mulBarrett(ringQ, row[i], sks[i-1].Value, tmp)
+			ringQ.Sub(phase, tmp, phase)
+		}
+
+		e := ringQ.NewPoly()
+		gs.Read(e)
+		ringQ.Add(phase, e, row[0])
+
+		gk.Value[l] = row
+
+		ringQ.MulScalar(scaled, relinBase, scaled)
+	}
+
+	return gk
+}
+
+func decompose(ringQ *ring.Ring, v ring.Poly) []ring.Poly {
+
+	Q := ringQ.SubRings[0].Modulus
+	L := relinLevels(Q)
+
+	digits := make([]ring.Poly, L)
+	for l := range digits {
+		digits[l] = ringQ.NewPoly()
+	}
+
+	for n, c := range v.Coeffs[0] {
+		for l := 0; l < L; l++ {
+			digits[l].Coeffs[0][n] = c % relinBase
+			c /= relinBase
+		}
+	}
+
+	return digits
+}
+
+func applyGadgetKey(ringQ *ring.Ring, gk *gadgetKey, d ring.Poly, acc []ring.Poly) {
+
+	digits := decompose(ringQ, d)
+
+	tmp := ringQ.NewPoly()
+	for l, digit := range digits {
+		for m, row := range gk.Value[l] {
+			mulBarrett(ringQ, digit, row, tmp)
+			ringQ.Add(acc[m], tmp, acc[m])
+		}
+	}
+}
+
+// Add homomorphically adds ct0 and ct1, which must carry the same Scale, and returns the
+// result in a new Ciphertext.
+func Add(params Parameters, ct0, ct1 *Ciphertext) (ctOut *Ciphertext, err error) {
+
+	if ct0.Scale != ct1.Scale {
+		return nil, fmt.Errorf("cannot Add: ciphertexts have different scales (%f != %f)", ct0.Scale, ct1.Scale)
+	}
+
+	if ct0.Parties() != ct1.Parties() {
+		return nil, fmt.Errorf("cannot Add: ciphertexts are encrypted for a different number of parties (%d != %d)", ct0.Parties(), ct1.Parties())
+	}
+
+	ringQ := params.RingQ()
+
+	value := make([]ring.Poly, len(ct0.Value))
+	for i := range value {
+		value[i] = ringQ.NewPoly()
+		ringQ.Add(ct0.Value[i], ct1.Value[i], value[i])
+	}
+
+	return &Ciphertext{Value: value, Scale: ct0.Scale}, nil
+}
+
+// tensor computes the raw (k+1)x(k+1) cross terms of ct0 and ct1. Unlike mkbfv's tensor,
+// no rescale is applied here: CKKS tracks the growth of the plaintext scale in Ciphertext.Scale
+// instead of immediately dividing it back down, leaving that to a caller-driven rescale,
+// which this minimal package does not implement.
+func tensor(params Parameters, ct0, ct1 *Ciphertext) [][]ring.Poly {
+
+	ringQ := params.RingQ()
+	k := ct0.Parties()
+
+	d := make([][]ring.Poly, k+1)
+	for i := range d {
+		d[i] = make([]ring.Poly, k+1)
+		for j := range d[i] {
+			d[i][j] = ringQ.NewPoly()
+			mulBarrett(ringQ, ct0.Value[i], ct1.Value[j], d[i][j])
+		}
+	}
+
+	return d
+}
+
+// MultiplyRelin homomorphically multiplies ct0 and ct1 and relinearizes the result back
+// down to a size-(k+1) Ciphertext, using a RelinearizationKey generated ahead of time by
+// GenRelinearizationKeyNew, see mkbfv.Relinearize. The output Scale is ct0.Scale * ct1.Scale.
+func MultiplyRelin(params Parameters, ct0, ct1 *Ciphertext, rlk *RelinearizationKey) (ctOut *Ciphertext, err error) {
+
+	k := ct0.Parties()
+
+	if k != ct1.Parties() || k != rlk.Parties {
+		return nil, fmt.Errorf("cannot MultiplyRelin: ciphertexts and relinearization key must all be for the same %d parties", k)
+	}
+
+	ringQ := params.RingQ()
+	d := tensor(params, ct0, ct1)
+
+	value := make([]ring.Poly, k+1)
+	for i := range value {
+		value[i] = ringQ.NewPoly()
+	}
+
+	for i := 0; i <= k; i++ {
+		ringQ.Add(value[i], d[0][i], value[i])
+		if i > 0 {
+			ringQ.Add(value[i], d[i][0], value[i])
+		}
+	}
+
+	for i := 1; i <= k; i++ {
+		for j := 1; j <= k; j++ {
+			applyGadgetKey(ringQ, rlk.Keys[[2]int{i, j}], d[i][j], value)
+		}
+	}
+
+	return &Ciphertext{Value: value, Scale: ct0.Scale * ct1.Scale}, nil
+}
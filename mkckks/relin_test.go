@@ -0,0 +1,91 @@
+package mkckks
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testParameters(t require.TestingT) (params Parameters, kgen *KeyGenerator) {
+
+	params, err := NewParameters(4, 3, 30)
+	require.NoError(t, err)
+
+	kgen, err = NewKeyGenerator(params)
+	require.NoError(t, err)
+
+	return
+}
+
+// TestMultiplyRelin checks that, for k=3 parties, relinearizing the product of two
+// Ciphertexts decrypts to the expected value, within the approximation error CKKS allows.
+func TestMultiplyRelin(t *testing.T) {
+
+	params, kgen := testParameters(t)
+
+	sks := make([]*SecretKey, params.Parties)
+	for i := range sks {
+		sks[i] = kgen.GenSecretKeyNew()
+	}
+
+	enc, err := NewEncryptor(params, sks)
+	require.NoError(t, err)
+
+	dec := NewDecryptor(params, sks)
+
+	const scale = 1 << 10
+
+	a, b := 3.25, -1.5
+
+	ct0, err := enc.EncryptNew(a, scale)
+	require.NoError(t, err)
+
+	ct1, err := enc.EncryptNew(b, scale)
+	require.NoError(t, err)
+
+	rlk, err := GenRelinearizationKeyNew(params, sks)
+	require.NoError(t, err)
+
+	ctOut, err := MultiplyRelin(params, ct0, ct1, rlk)
+	require.NoError(t, err)
+
+	got, err := dec.DecryptNew(ctOut)
+	require.NoError(t, err)
+
+	require.Less(t, math.Abs(got-a*b), 5e-2)
+}
+
+// TestAdd checks that adding two same-scale Ciphertexts decrypts to the expected sum.
+func TestAdd(t *testing.T) {
+
+	params, kgen := testParameters(t)
+
+	sks := make([]*SecretKey, params.Parties)
+	for i := range sks {
+		sks[i] = kgen.GenSecretKeyNew()
+	}
+
+	enc, err := NewEncryptor(params, sks)
+	require.NoError(t, err)
+
+	dec := NewDecryptor(params, sks)
+
+	const scale = 1 << 10
+
+	a, b := 3.25, -1.5
+
+	ct0, err := enc.EncryptNew(a, scale)
+	require.NoError(t, err)
+
+	ct1, err := enc.EncryptNew(b, scale)
+	require.NoError(t, err)
+
+	ctOut, err := Add(params, ct0, ct1)
+	require.NoError(t, err)
+
+	got, err := dec.DecryptNew(ctOut)
+	require.NoError(t, err)
+
+	require.Less(t, math.Abs(got-(a+b)), 1e-2)
+}
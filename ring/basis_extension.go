@@ -184,6 +184,13 @@ func (be *BasisExtender) ShallowCopy() *BasisExtender {
 // ModUpQtoP extends the RNS basis of a polynomial from Q to QP.
 // Given a polynomial with coefficients in basis {Q0,Q1....Qlevel},
 // it extends its basis from {Q0,Q1....Qlevel} to {Q0,Q1....Qlevel,P0,P1...Pj}
+//
+// polQ is assumed to be in the NTT-free (coefficient) domain and already reduced modulo Q at
+// levelQ; polP must be sized for levelP and is fully overwritten. The extension recenters
+// polQ's representatives around zero before reducing into P and uncenters the result, so that
+// the extension is exact (not merely congruent mod the respective Qi/Pj) for the centered
+// representative of polQ's value, i.e. the one within [-Q/2, Q/2); a value in [Q/2, Q) is
+// extended as that value minus Q.
 func (be *BasisExtender) ModUpQtoP(levelQ, levelP int, polQ, polP Poly) {
 
 	ringQ := be.ringQ.AtLevel(levelQ)
@@ -185,3 +185,30 @@ func CRed(a, q uint64) uint64 {
 	}
 	return a
 }
+
+// CRedConstantTime is a constant-time version of CRed: it returns a mod q where a is
+// between 0 and 2*q-1, without branching on a. It subtracts q from a and selects, via a
+// mask derived from the sign of the subtraction, between the subtracted and original value.
+func CRedConstantTime(a, q uint64) uint64 {
+	b := a - q
+	mask := -(b >> 63)
+	return b + (mask & q)
+}
+
+// BRedAddConstantTime is a constant-time version of BRedAdd: it computes a mod q without
+// branching on the result of the Barrett reduction.
+func BRedAddConstantTime(a, q uint64, u []uint64) (r uint64) {
+	return CRedConstantTime(BRedAddLazy(a, q, u), q)
+}
+
+// BRedConstantTime is a constant-time version of BRed: it computes x*y mod q without
+// branching on the result of the Barrett reduction.
+func BRedConstantTime(x, y, q uint64, u []uint64) (r uint64) {
+	return CRedConstantTime(BRedLazy(x, y, q, u), q)
+}
+
+// MRedConstantTime is a constant-time version of MRed: it computes x*y*(1/2^64) mod q
+// without branching on the result of the Montgomery reduction.
+func MRedConstantTime(x, y, q, qInv uint64) (r uint64) {
+	return CRedConstantTime(MRedLazy(x, y, q, qInv), q)
+}
@@ -24,6 +24,18 @@ func BenchmarkNTT(b *testing.B) {
 	benchINTT(16, 1, b)
 }
 
+// BenchmarkNTTScaling measures the cost of the forward and inverse NTT across a range of ring
+// degrees and modulus counts, so that the reported ns/op figures can be used to calibrate a cost
+// estimator against real hardware.
+func BenchmarkNTTScaling(b *testing.B) {
+	for _, LogN := range []int{12, 13, 14, 15} {
+		for _, Qi := range []int{1, 2, 4, 8} {
+			benchNTT(LogN, Qi, b)
+			benchINTT(LogN, Qi, b)
+		}
+	}
+}
+
 func benchNTT(LogN, Qi int, b *testing.B) {
 	b.Run(fmt.Sprintf("Forward/N=%d/Qi=%d", 1<<LogN, Qi), func(b *testing.B) {
 		r, err := NewRing(1<<LogN, Qi60[:Qi])
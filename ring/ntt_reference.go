@@ -0,0 +1,154 @@
+package ring
+
+import "fmt"
+
+// NumberTheoreticTransformerReference is an experimental reference implementation of the negacyclic NTT
+// in Z[X]/(X^N+1). Unlike NumberTheoreticTransformerStandard, which requires N to be a power of two so
+// that it can use a radix-2 Cooley-Tukey butterfly network, NumberTheoreticTransformerReference evaluates
+// the defining sum directly and works for any N for which a primitive 2N-th root of unity exists modulo
+// the SubRing's Modulus:
+//
+//	NTT(a)_i = sum_{j=0}^{N-1} a_j * psi^{(2i+1)j mod 2N} mod Modulus,  i = 0, ..., N-1
+//
+// and its inverse
+//
+//	a_j = N^-1 * sum_{i=0}^{N-1} NTT(a)_i * psi^{-(2i+1)j mod 2N} mod Modulus,  j = 0, ..., N-1
+//
+// where psi is a primitive 2N-th root of unity modulo Modulus. This is the O(N^2) textbook DFT that the
+// radix-2 algorithm accelerates to O(N log N); it exists to support ring degrees, such as the N = 3*2^k
+// handled by NewRingNonPow2, for which that fast algorithm does not apply. It is a tool for evaluating
+// the feasibility of such degrees, not for production use: both its time complexity and its use of plain
+// (non-Montgomery, non-lazy) modular reduction on every step make it considerably slower than
+// NumberTheoreticTransformerStandard.
+type NumberTheoreticTransformerReference struct {
+	N            int
+	Modulus      uint64
+	BRedConstant []uint64
+	NInv         uint64   // [N^-1] mod Modulus
+	powPsi       []uint64 // powPsi[k] = psi^k mod Modulus, k = 0, ..., 2N-1
+	powPsiInv    []uint64 // powPsiInv[k] = psi^-k mod Modulus, k = 0, ..., 2N-1
+}
+
+// NewNumberTheoreticTransformerReference creates a NumberTheoreticTransformerReference of degree n for the
+// modulus of the given SubRing. Unlike NewNumberTheoreticTransformerStandard, it does not read s.RootsForward
+// or s.RootsBackward: it finds a primitive 2n-th root of unity and builds its own tables from scratch, so
+// that it can be used on a SubRing that generateNTTConstants (which assumes a power-of-two NthRoot) cannot
+// populate. Modulus must be prime and equal to 1 modulo 2n.
+func NewNumberTheoreticTransformerReference(s *SubRing, n int) (ntt NumberTheoreticTransformer, err error) {
+
+	Modulus := s.Modulus
+
+	if !IsPrime(Modulus) {
+		return nil, fmt.Errorf("invalid modulus: %d is not prime", Modulus)
+	}
+
+	NthRoot := uint64(2 * n)
+
+	if (Modulus-1)%NthRoot != 0 {
+		return nil, fmt.Errorf("invalid modulus: %d != 1 mod %d", Modulus, NthRoot)
+	}
+
+	g, _, err := PrimitiveRoot(Modulus, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	psi := ModExp(g, (Modulus-1)/NthRoot, Modulus)
+	psiInv := ModExp(psi, Modulus-2, Modulus)
+
+	BRedConstant := BRedConstant(Modulus)
+
+	powPsi := make([]uint64, NthRoot)
+	powPsiInv := make([]uint64, NthRoot)
+
+	powPsi[0] = 1
+	powPsiInv[0] = 1
+
+	for k := uint64(1); k < NthRoot; k++ {
+		powPsi[k] = BRed(powPsi[k-1], psi, Modulus, BRedConstant)
+		powPsiInv[k] = BRed(powPsiInv[k-1], psiInv, Modulus, BRedConstant)
+	}
+
+	return &NumberTheoreticTransformerReference{
+		N:            n,
+		Modulus:      Modulus,
+		BRedConstant: BRedConstant,
+		NInv:         ModExp(uint64(n), Modulus-2, Modulus),
+		powPsi:       powPsi,
+		powPsiInv:    powPsiInv,
+	}, nil
+}
+
+// Forward writes the forward NTT in Z[X]/(X^N+1) of p1 on p2.
+func (rntt *NumberTheoreticTransformerReference) Forward(p1, p2 []uint64) {
+	// X_i = sum_j a_j * psi^{(2i+1)j}: for fixed i, the exponent (2i+1)*j grows by (2i+1) as j increments.
+	rntt.transform(p1, p2, rntt.powPsi, func(i int) uint64 { return 0 }, func(i int) uint64 { return uint64(2*i + 1) })
+}
+
+// ForwardLazy writes the forward NTT in Z[X]/(X^N+1) of p1 on p2. NumberTheoreticTransformerReference
+// always reduces its output to [0, Modulus), so this is an alias of Forward.
+func (rntt *NumberTheoreticTransformerReference) ForwardLazy(p1, p2 []uint64) {
+	rntt.Forward(p1, p2)
+}
+
+// Backward writes the backward NTT in Z[X]/(X^N+1) of p1 on p2.
+func (rntt *NumberTheoreticTransformerReference) Backward(p1, p2 []uint64) {
+
+	// a_j = N^-1 * sum_i X_i * psi^{-(2i+1)j}: for fixed j, the exponent (2i+1)*j = 2j*i + j grows by
+	// 2j as i increments, starting from j (unlike Forward, here the "+1" term lands on the outer index).
+	rntt.transform(p1, p2, rntt.powPsiInv, func(j int) uint64 { return uint64(j) }, func(j int) uint64 { return uint64(2 * j) })
+
+	for i, v := range p2 {
+		p2[i] = BRed(v, rntt.NInv, rntt.Modulus, rntt.BRedConstant)
+	}
+}
+
+// BackwardLazy writes the backward NTT in Z[X]/(X^N+1) of p1 on p2. NumberTheoreticTransformerReference
+// always reduces its output to [0, Modulus), so this is an alias of Backward.
+func (rntt *NumberTheoreticTransformerReference) BackwardLazy(p1, p2 []uint64) {
+	rntt.Backward(p1, p2)
+}
+
+// transform evaluates, for every outer index o = 0, ..., N-1, p2[o] = sum_{k=0}^{N-1} p1[k] *
+// pow[e mod len(pow)] mod Modulus, where e starts at start(o) and is incremented by step(o) at every
+// step of the inner loop. This single O(N^2) core is shared by Forward and Backward, which differ only
+// in which operand of the twisted-DFT exponent (2i+1)*j plays the role of the outer, per-row index.
+//
+// p1 and p2 are allowed to be the same slice, as callers (e.g. Ring.INTT) are entitled to transform in
+// place: results are accumulated into a scratch buffer and only copied into p2 once every p2[o] has
+// been computed from the untouched p1.
+func (rntt *NumberTheoreticTransformerReference) transform(p1, p2 []uint64, pow []uint64, start, step func(o int) uint64) {
+
+	N := rntt.N
+	Modulus := rntt.Modulus
+	NthRoot := uint64(len(pow))
+	BRedConstant := rntt.BRedConstant
+
+	out := make([]uint64, N)
+
+	for o := 0; o < N; o++ {
+
+		s := step(o) % NthRoot
+
+		var sum, e uint64 = 0, start(o)%NthRoot
+
+		for k := 0; k < N; k++ {
+
+			if p1[k] != 0 {
+				sum += BRed(p1[k], pow[e], Modulus, BRedConstant)
+				if sum >= Modulus {
+					sum -= Modulus
+				}
+			}
+
+			e += s
+			if e >= NthRoot {
+				e -= NthRoot
+			}
+		}
+
+		out[o] = sum
+	}
+
+	copy(p2, out)
+}
@@ -117,3 +117,45 @@ func TestNTT(t *testing.T) {
 		})
 	}
 }
+
+// delegatingNTT is a NumberTheoreticTransformer that simply forwards every call to a
+// wrapped NumberTheoreticTransformerStandard. It exists only to exercise
+// NewRingWithCustomNTT with a user-provided transformer, e.g. an alternative or
+// hardware-tuned implementation, instead of the package's default.
+type delegatingNTT struct {
+	NumberTheoreticTransformer
+}
+
+func newDelegatingNTT(s *SubRing, n int) NumberTheoreticTransformer {
+	return delegatingNTT{NumberTheoreticTransformer: NewNumberTheoreticTransformerStandard(s, n)}
+}
+
+func TestNewRingWithCustomNTT(t *testing.T) {
+
+	for _, tv := range testVector[:] {
+
+		ringQ, err := NewRingWithCustomNTT(tv.N, tv.Qis, newDelegatingNTT, 2*tv.N)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Run(fmt.Sprintf("N=%d/limbs=%d", ringQ.N(), ringQ.ModuliChainLength()), func(t *testing.T) {
+
+			x := ringQ.NewPoly()
+			y := ringQ.NewPoly()
+			z := ringQ.NewPoly()
+
+			x.Copy(tv.poly)
+			y.Copy(tv.polyNTT)
+
+			ringQ.NTT(x, z)
+
+			assert.True(t, ringQ.Equal(z, y), "transformed poly and polyNTT should match")
+
+			ringQ.INTT(z, z)
+
+			assert.True(t, ringQ.Equal(z, x), "invNTT should reverse NTT")
+		})
+	}
+}
@@ -56,6 +56,15 @@ func (r Ring) ReduceLazy(p1, p2 Poly) {
 	}
 }
 
+// ReduceConstantTime evaluates p2 = p1 coefficient-wise mod modulus in the ring, in constant
+// time, i.e. without a data-dependent conditional subtraction. Use this instead of Reduce in
+// deployments where a timing side-channel on the coefficients is a concern.
+func (r Ring) ReduceConstantTime(p1, p2 Poly) {
+	for i, s := range r.SubRings[:r.level+1] {
+		s.ReduceConstantTime(p1.Coeffs[i], p2.Coeffs[i])
+	}
+}
+
 // MulCoeffsBarrett evaluates p3 = p1 * p2 coefficient-wise in the ring, with Barrett reduction.
 func (r Ring) MulCoeffsBarrett(p1, p2, p3 Poly) {
 	for i, s := range r.SubRings[:r.level+1] {
@@ -112,6 +121,29 @@ func (r Ring) MulCoeffsMontgomeryThenAdd(p1, p2, p3 Poly) {
 	}
 }
 
+// MulCoeffsMontgomeryThenAddVec evaluates acc = acc + sum_k a[k] * b[k] coefficient-wise in the
+// ring, with Montgomery reduction. a and b must have the same length K, one Poly per term to
+// accumulate. This is equivalent to calling MulCoeffsMontgomeryThenAdd once per pair, but fuses the
+// K accumulations into a single pass over acc's coefficients instead of K separate ones, improving
+// cache locality when K is large, e.g. the base decomposition loop of GadgetProduct.
+func (r Ring) MulCoeffsMontgomeryThenAddVec(a, b []Poly, acc Poly) {
+
+	K := len(a)
+
+	coeffsA := make([][]uint64, K)
+	coeffsB := make([][]uint64, K)
+
+	for i, s := range r.SubRings[:r.level+1] {
+
+		for k := 0; k < K; k++ {
+			coeffsA[k] = a[k].Coeffs[i]
+			coeffsB[k] = b[k].Coeffs[i]
+		}
+
+		s.MulCoeffsMontgomeryThenAddVec(coeffsA, coeffsB, acc.Coeffs[i])
+	}
+}
+
 // MulCoeffsMontgomeryThenAddLazy evaluates p3 = p3 + p1 * p2 coefficient-wise in the ring, with Montgomery reduction, with p3 in [0, 2*modulus-1].
 func (r Ring) MulCoeffsMontgomeryThenAddLazy(p1, p2, p3 Poly) {
 	for i, s := range r.SubRings[:r.level+1] {
@@ -219,6 +251,18 @@ func (r Ring) MulRNSScalarMontgomery(p1 Poly, scalar RNSScalar, p2 Poly) {
 	}
 }
 
+// PowScalar evaluates p2 = p1^exp coefficient-wise in the ring, each coefficient being raised to
+// the power exp modulo the modulus of its RNS limb, via ModExp's binary (square-and-multiply)
+// exponentiation.
+func (r Ring) PowScalar(p1 Poly, exp uint64, p2 Poly) {
+	for i, s := range r.SubRings[:r.level+1] {
+		c1, c2 := p1.Coeffs[i], p2.Coeffs[i]
+		for j := range c1 {
+			c2[j] = ModExp(c1[j], exp, s.Modulus)
+		}
+	}
+}
+
 // MulScalarThenSub evaluates p2 = p2 - p1 * scalar coefficient-wise in the ring.
 func (r Ring) MulScalarThenSub(p1 Poly, scalar uint64, p2 Poly) {
 	for i, s := range r.SubRings[:r.level+1] {
@@ -2,6 +2,8 @@ package ring
 
 import (
 	"bufio"
+	"encoding/binary"
+	"hash/fnv"
 	"io"
 
 	"github.com/tuneinsight/lattigo/v5/utils"
@@ -75,9 +77,12 @@ func (pol *Poly) Copy(p1 Poly) {
 	pol.CopyLvl(p1.Level(), p1)
 }
 
-// CopyLvl copies the coefficients of p1 on the target polynomial.
+// CopyLvl copies the coefficients 0..level of p1 on the target polynomial.
 // This method does nothing if the underlying arrays are the same.
 // Expects the degree of both polynomials to be identical.
+// Unlike Copy, CopyLvl never resizes the target, so it performs no allocation as long as the
+// target was already allocated with at least level+1 RNS limbs (e.g. from a Ring.NewPoly or a
+// PolyPool), making it suitable for reusing a preallocated buffer across loop iterations.
 func (pol *Poly) CopyLvl(level int, p1 Poly) {
 	for i := 0; i < level+1; i++ {
 		if !utils.Alias1D(pol.Coeffs[i], p1.Coeffs[i]) {
@@ -89,9 +94,33 @@ func (pol *Poly) CopyLvl(level int, p1 Poly) {
 // Equal returns true if the receiver Poly is equal to the provided other Poly.
 // This function checks for strict equality between the polynomial coefficients
 // (i.e., it does not consider congruence as equality within the ring like
-// `Ring.Equal` does).
+// `Ring.Equal` does). Two polynomials at different levels are never equal.
 func (pol Poly) Equal(other *Poly) bool {
-	return pol.Coeffs.Equal(other.Coeffs)
+	return pol.Level() == other.Level() && pol.Coeffs.Equal(other.Coeffs)
+}
+
+// Hash returns a structural, non-cryptographic hash of the polynomial's coefficients, computed
+// with FNV-1a over the level and the coefficients in their stored (non-centered) representation.
+// It is meant as a cache/map key for memoizing a computation on a Poly's value, not as a collision-
+// resistant digest. Two polynomials that are Equal always have the same Hash; as with any hash, the
+// converse is not guaranteed.
+func (pol Poly) Hash() uint64 {
+
+	h := fnv.New64a()
+
+	var buf [8]byte
+
+	binary.LittleEndian.PutUint64(buf[:], uint64(pol.Level()))
+	h.Write(buf[:])
+
+	for _, coeffs := range pol.Coeffs {
+		for _, c := range coeffs {
+			binary.LittleEndian.PutUint64(buf[:], c)
+			h.Write(buf[:])
+		}
+	}
+
+	return h.Sum64()
 }
 
 // BinarySize returns the serialized size of the object in bytes.
@@ -0,0 +1,38 @@
+package ring
+
+import "sync"
+
+// PolyPool is a sync.Pool-backed allocator of Poly for a specific Ring, meant to cut the
+// garbage-collector pressure caused by repeatedly allocating and discarding short-lived
+// temporary polynomials in hot paths (e.g. a key-switching gadget decomposition or an
+// Evaluator's internal scratch buffers).
+//
+// A PolyPool only hands out polynomials sized according to the Ring it was created for;
+// a Poly obtained from one PolyPool must not be returned to a different one.
+//
+// Unlike Ring.NewPoly, Get does not guarantee that the returned Poly's coefficients are
+// zero: a reused Poly still carries whatever its previous owner last wrote to it. Callers
+// that need a clean polynomial must reduce, overwrite, or explicitly zero it themselves.
+type PolyPool struct {
+	r    *Ring
+	pool sync.Pool
+}
+
+// NewPolyPool creates a new PolyPool of polynomials sized for r.
+func NewPolyPool(r *Ring) *PolyPool {
+	return &PolyPool{r: r}
+}
+
+// Get returns a Poly sized for the PolyPool's Ring, either reused from the pool or freshly
+// allocated. The returned Poly should be returned to the pool with Put once no longer needed.
+func (p *PolyPool) Get() Poly {
+	if v := p.pool.Get(); v != nil {
+		return v.(Poly)
+	}
+	return p.r.NewPoly()
+}
+
+// Put returns pol to the pool for reuse. The caller must not use pol after this call.
+func (p *PolyPool) Put(pol Poly) {
+	p.pool.Put(pol)
+}
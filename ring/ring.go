@@ -210,6 +210,26 @@ func (r Ring) ModuliChain() (moduli []uint64) {
 	return
 }
 
+// CompatibleWith returns true if r and other share the same ring degree and
+// the same modulus chain, i.e. if ciphertexts and polynomials of one ring can
+// be used interchangeably with the other (e.g. for NTT-domain RNS operations
+// that assume matching moduli).
+func (r Ring) CompatibleWith(other *Ring) bool {
+
+	if r.N() != other.N() || r.ModuliChainLength() != other.ModuliChainLength() {
+		return false
+	}
+
+	moduli, otherModuli := r.ModuliChain(), other.ModuliChain()
+	for i := range moduli {
+		if moduli[i] != otherModuli[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Modulus returns the modulus of the target ring at the currently
 // set level in *big.Int.
 func (r Ring) Modulus() *big.Int {
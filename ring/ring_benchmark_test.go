@@ -24,6 +24,7 @@ func BenchmarkRing(b *testing.B) {
 		benchSampling(tc, b)
 		benchMontgomery(tc, b)
 		benchMulCoeffs(tc, b)
+		benchMulCoeffsMontgomeryThenAddVec(tc, b)
 		benchAddCoeffs(tc, b)
 		benchSubCoeffs(tc, b)
 		benchNegCoeffs(tc, b)
@@ -51,6 +52,31 @@ func benchMarshalling(tc *testParams, b *testing.B) {
 
 	var err error
 
+	// Compares against benchGenRing/GenRing: unmarshalling a Ring from data produced by an
+	// already-initialized one should be substantially cheaper, since it can restore the
+	// precomputed NTT tables instead of regenerating them.
+	var ringData []byte
+	if ringData, err = tc.ringQ.MarshalBinary(); err != nil {
+		b.Error(err)
+	}
+
+	b.Run(testString("Marshalling/MarshalRing", tc.ringQ), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err = tc.ringQ.MarshalBinary(); err != nil {
+				b.Error(err)
+			}
+		}
+	})
+
+	b.Run(testString("Marshalling/UnmarshalRing", tc.ringQ), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ringQTest := new(Ring)
+			if err = ringQTest.UnmarshalBinary(ringData); err != nil {
+				b.Error(err)
+			}
+		}
+	})
+
 	p := tc.uniformSamplerQ.ReadNew()
 
 	b.Run(testString("Marshalling/MarshalPoly", tc.ringQ), func(b *testing.B) {
@@ -128,6 +154,24 @@ func benchSampling(tc *testParams, b *testing.B) {
 			sampler.Read(pol)
 		}
 	})
+
+	b.Run(testString("Sampling/UniformCT", tc.ringQ), func(b *testing.B) {
+
+		sampler := NewUniformSamplerCT(tc.prng, tc.ringQ)
+
+		for i := 0; i < b.N; i++ {
+			sampler.Read(pol)
+		}
+	})
+
+	b.Run(testString("Sampling/GaussianCT", tc.ringQ), func(b *testing.B) {
+
+		sampler := NewGaussianSamplerCT(tc.prng, tc.ringQ, DiscreteGaussian{Sigma: DefaultSigma, Bound: DefaultBound}, false)
+
+		for i := 0; i < b.N; i++ {
+			sampler.Read(pol)
+		}
+	})
 }
 
 func benchMontgomery(tc *testParams, b *testing.B) {
@@ -177,6 +221,38 @@ func benchMulCoeffs(tc *testParams, b *testing.B) {
 	})
 }
 
+func benchMulCoeffsMontgomeryThenAddVec(tc *testParams, b *testing.B) {
+
+	ringQ := tc.ringQ
+
+	// K approximates a typical RNS base decomposition vector size (e.g. GadgetProduct's Beta),
+	// the setting this fusion targets.
+	const K = 5
+
+	a := make([]Poly, K)
+	bb := make([]Poly, K)
+	for k := 0; k < K; k++ {
+		a[k] = tc.uniformSamplerQ.ReadNew()
+		bb[k] = tc.uniformSamplerQ.ReadNew()
+	}
+
+	acc := ringQ.NewPoly()
+
+	b.Run(testString("MulCoeffs/MontgomeryThenAdd/Loop", ringQ), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for k := 0; k < K; k++ {
+				ringQ.MulCoeffsMontgomeryThenAdd(a[k], bb[k], acc)
+			}
+		}
+	})
+
+	b.Run(testString("MulCoeffs/MontgomeryThenAdd/Vec", ringQ), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ringQ.MulCoeffsMontgomeryThenAddVec(a, bb, acc)
+		}
+	})
+}
+
 func benchAddCoeffs(tc *testParams, b *testing.B) {
 
 	p0 := tc.uniformSamplerQ.ReadNew()
@@ -0,0 +1,98 @@
+package ring
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+
+	"github.com/tuneinsight/lattigo/v5/utils"
+	"github.com/tuneinsight/lattigo/v5/utils/bignum"
+)
+
+// isValidNonPow2Degree returns true if N = 3*2^k for some k >= 3, i.e. N is a multiple of 24 whose
+// quotient by 3 is a power of two. The k >= 3 floor (equivalently N%8 == 0) is not mathematically
+// required by the NTT itself: it is required because the loop-unrolled, 8-coefficients-at-a-time vector
+// operations used throughout this package (see vec_ops.go) assume N is a multiple of 8 regardless of
+// which NumberTheoreticTransformer a SubRing carries.
+func isValidNonPow2Degree(N int) bool {
+	if N <= 0 || N%24 != 0 {
+		return false
+	}
+	m := N / 3
+	return m&(m-1) == 0
+}
+
+// NewRingNonPow2 creates an experimental RNS Ring of degree N, where N is of the form 3*2^k (k >= 3),
+// rather than the power-of-two degree required by NewRing. Moduli should be a non-empty []uint64 with
+// distinct prime elements, each equal to 1 modulo 2*N.
+//
+// Unlike NewRing, which relies on the power-of-two-specific radix-2 NTT of NumberTheoreticTransformerStandard,
+// NewRingNonPow2 uses NumberTheoreticTransformerReference, an O(N^2) direct evaluation of the NTT's defining
+// sum. It exists to let callers evaluate the feasibility of packing layouts that need a ring degree with
+// small prime factors other than two, not for production use: there is currently no mixed-radix fast NTT
+// in this package, so every polynomial multiplication in the returned Ring is asymptotically more expensive
+// than in a power-of-two Ring of comparable degree.
+//
+// An error is returned with a nil *Ring if N is not of the supported form, or if Moduli is not NTT-enabling.
+func NewRingNonPow2(N int, Moduli []uint64) (r *Ring, err error) {
+
+	if !isValidNonPow2Degree(N) {
+		return nil, fmt.Errorf("invalid ring degree: must be of the form 3*2^k for k >= 3, but is %d", N)
+	}
+
+	if len(Moduli) == 0 {
+		return nil, fmt.Errorf("invalid ModuliChain (must be a non-empty []uint64)")
+	}
+
+	if !utils.AllDistinct(Moduli) {
+		return nil, fmt.Errorf("invalid ModuliChain (moduli are not distinct)")
+	}
+
+	r = new(Ring)
+
+	r.ModulusAtLevel = make([]*big.Int, len(Moduli))
+	r.ModulusAtLevel[0] = bignum.NewInt(Moduli[0])
+	for i := 1; i < len(Moduli); i++ {
+		r.ModulusAtLevel[i] = new(big.Int).Mul(r.ModulusAtLevel[i-1], bignum.NewInt(Moduli[i]))
+	}
+
+	r.SubRings = make([]*SubRing, len(Moduli))
+
+	for i := range r.SubRings {
+		if r.SubRings[i], err = newSubRingNonPow2(N, Moduli[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	r.RescaleConstants = rewRescaleConstants(r.SubRings)
+
+	r.level = len(Moduli) - 1
+
+	return r, nil
+}
+
+// newSubRingNonPow2 creates a SubRing of degree N backed by a NumberTheoreticTransformerReference.
+func newSubRingNonPow2(N int, Modulus uint64) (s *SubRing, err error) {
+
+	s = &SubRing{}
+
+	s.N = N
+	s.Modulus = Modulus
+	s.Mask = (1 << uint64(bits.Len64(Modulus-1))) - 1
+	s.BRedConstant = BRedConstant(Modulus)
+
+	// If qi is not a power of 2, we can compute the MRed (otherwise, it
+	// would return an error as there is no valid Montgomery form mod a power of 2)
+	if (Modulus&(Modulus-1)) != 0 && Modulus != 0 {
+		s.MRedConstant = MRedConstant(Modulus)
+	}
+
+	s.NTTTable = new(NTTTable)
+	s.NthRoot = uint64(2 * N)
+
+	if s.ntt, err = NewNumberTheoreticTransformerReference(s, N); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
@@ -0,0 +1,100 @@
+package ring
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRingNonPow2(t *testing.T) {
+
+	t.Run("InvalidDegree", func(t *testing.T) {
+		for _, N := range []int{0, 3, 8, 12, 1024, 3 * 4} {
+			_, err := NewRingNonPow2(N, []uint64{1048609})
+			require.Error(t, err)
+		}
+	})
+
+	Qis := []uint64{1048609, 1048897}
+
+	for _, N := range []int{24, 48} {
+
+		ringQ, err := NewRingNonPow2(N, Qis)
+		require.NoError(t, err)
+
+		t.Run(fmt.Sprintf("N=%d/limbs=%d", ringQ.N(), ringQ.ModuliChainLength()), func(t *testing.T) {
+
+			x := ringQ.NewPoly()
+			for i, coeffs := range x.Coeffs {
+				for j := range coeffs {
+					coeffs[j] = uint64(i+j) % Qis[i]
+				}
+			}
+
+			z := ringQ.NewPoly()
+
+			ringQ.NTT(x, z)
+			ringQ.INTT(z, z)
+
+			assert.True(t, ringQ.Equal(z, x), "INTT(NTT(x)) should reverse back to x")
+
+			// Sanity-checks that the NTT domain is actually multiplicative: a coefficient-domain
+			// product computed via schoolbook convolution in Z[X]/(X^N+1) should match a
+			// coefficient-wise product of the two operands' NTTs, brought back with INTT.
+			a := ringQ.NewPoly()
+			b := ringQ.NewPoly()
+			for i := range a.Coeffs {
+				for j := 0; j < N; j++ {
+					a.Coeffs[i][j] = uint64(j+1) % Qis[i]
+					b.Coeffs[i][j] = uint64(2*j+1) % Qis[i]
+				}
+			}
+
+			want := ringQ.NewPoly()
+			negacyclicSchoolbookMul(a, b, want, Qis)
+
+			aNTT := ringQ.NewPoly()
+			bNTT := ringQ.NewPoly()
+			ringQ.NTT(a, aNTT)
+			ringQ.NTT(b, bNTT)
+
+			have := ringQ.NewPoly()
+			ringQ.MulCoeffsBarrett(aNTT, bNTT, have)
+			ringQ.INTT(have, have)
+
+			assert.True(t, ringQ.Equal(have, want), "NTT-domain product should match schoolbook negacyclic convolution")
+		})
+	}
+}
+
+// negacyclicSchoolbookMul computes want = a*b mod (X^N+1, Qis) by direct convolution, as a reference
+// independent of the NTT under test.
+func negacyclicSchoolbookMul(a, b, want Poly, Qis []uint64) {
+
+	N := a.N()
+
+	for i := range a.Coeffs {
+
+		Q := Qis[i]
+
+		res := make([]uint64, 2*N)
+
+		for j := 0; j < N; j++ {
+			for k := 0; k < N; k++ {
+				res[j+k] = (res[j+k] + a.Coeffs[i][j]*b.Coeffs[i][k]) % Q
+			}
+		}
+
+		for j := 0; j < N; j++ {
+			v := res[j]
+			if res[j+N] <= v {
+				v -= res[j+N]
+			} else {
+				v = Q - (res[j+N] - v)
+			}
+			want.Coeffs[i][j] = v % Q
+		}
+	}
+}
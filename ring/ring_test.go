@@ -2,10 +2,12 @@ package ring
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/tuneinsight/lattigo/v5/utils/buffer"
 	"github.com/tuneinsight/lattigo/v5/utils/sampling"
@@ -74,8 +76,16 @@ func TestRing(t *testing.T) {
 		testWriterAndReader(tc, t)
 		testSampler(tc, t)
 		testModularReduction(tc, t)
+		testConstantTimeReduction(tc, t)
+		testMulCoeffsBarrett(tc, t)
+		testMulCoeffsMontgomeryThenAddVec(tc, t)
+		testPolyEqualAndHash(tc, t)
+		testPolyCopyLvl(tc, t)
+		testCompatibleWith(tc, t)
+		testPolyPool(tc, t)
 		testMForm(tc, t)
 		testMulScalarBigint(tc, t)
+		testPowScalar(tc, t)
 		testExtendBasis(tc, t)
 		testMultByMonomial(tc, t)
 
@@ -347,6 +357,48 @@ func testMarshalBinary(tc *testParams, t *testing.T) {
 		}
 
 		require.Equal(t, ringQTest, tc.ringQ)
+
+		// The unmarshalled SubRings must carry over the precomputed NTT tables, instead of
+		// only the data needed to regenerate them, and still produce correct NTT transforms.
+		for i, s := range ringQTest.SubRings[:ringQTest.level+1] {
+			require.Equal(t, tc.ringQ.SubRings[i].RootsForward, s.RootsForward)
+			require.Equal(t, tc.ringQ.SubRings[i].RootsBackward, s.RootsBackward)
+			require.Equal(t, tc.ringQ.SubRings[i].NInv, s.NInv)
+		}
+
+		p1 := tc.uniformSamplerQ.ReadNew()
+		p2 := ringQTest.NewPoly()
+		p3 := ringQTest.NewPoly()
+
+		ringQTest.NTT(p1, p2)
+		ringQTest.INTT(p2, p3)
+
+		require.True(t, ringQTest.Equal(p1, p3))
+	})
+
+	t.Run(testString("MarshalBinary/Ring/CorruptedNTTTables", tc.ringQ), func(t *testing.T) {
+
+		var err error
+
+		var data []byte
+		if data, err = tc.ringQ.MarshalBinary(); err != nil {
+			t.Fatal(err)
+		}
+
+		p := ringParametersLiteral{}
+		require.NoError(t, json.Unmarshal(data, &p))
+
+		// Flips a single root of the first SubRing, at an index that is not one of the
+		// positions (0, 1, n/2, n-1) a partial spot-check would sample: the forward and
+		// backward tables no longer agree with the modulus they are supposed to belong to,
+		// and this must be caught regardless of which index was corrupted.
+		p[0].RootsForward[2]++
+
+		corrupted, err := json.Marshal(p)
+		require.NoError(t, err)
+
+		ringQTest := new(Ring)
+		require.Error(t, ringQTest.UnmarshalBinary(corrupted))
 	})
 
 	t.Run(testString("MarshalBinary/Poly", tc.ringQ), func(t *testing.T) {
@@ -484,6 +536,59 @@ func testSampler(tc *testParams, t *testing.T) {
 		require.InDelta(t, math.Log2(1e21), tc.ringQ.Log2OfStandardDeviation(pol), 1)
 	})
 
+	// UniformSamplerCT and GaussianSamplerCT trade a fixed, tiny bias for reading a fixed number of
+	// PRNG bytes per coefficient: the checks below are the bound/distribution checks of their non-CT
+	// counterparts above (the uniform one also checks the mean and spread), which is enough to catch the CT reduction being statistically unsound
+	// (e.g. an off-by-one in the 128-bit modular reduction, or a broken Box-Muller discretization),
+	// since a meaningful bug would skew these statistics far beyond the bias the doc comments describe.
+	t.Run(testString("Sampler/UniformCT", tc.ringQ), func(t *testing.T) {
+
+		samplerCT := NewUniformSamplerCT(tc.prng, tc.ringQ)
+
+		pol := samplerCT.ReadNew()
+
+		for i, qi := range tc.ringQ.ModuliChain() {
+			coeffs := pol.Coeffs[i]
+
+			var sum, sumSq float64
+			for j := 0; j < N; j++ {
+				require.False(t, coeffs[j] > qi)
+				sum += float64(coeffs[j])
+				sumSq += float64(coeffs[j]) * float64(coeffs[j])
+			}
+
+			mean := sum / float64(N)
+			variance := sumSq/float64(N) - mean*mean
+
+			wantMean := float64(qi-1) / 2
+			wantStdDev := math.Sqrt((float64(qi)*float64(qi) - 1) / 12)
+
+			// Loose tolerances: this checks the distribution is in the right ballpark, not a
+			// precise statistical test, since N coefficients is a small sample for a modulus this wide.
+			require.InDelta(t, wantMean, mean, wantMean*0.05)
+			require.InDelta(t, wantStdDev, math.Sqrt(variance), wantStdDev*0.05)
+		}
+	})
+
+	t.Run(testString("Sampler/GaussianCT/SmallSigma", tc.ringQ), func(t *testing.T) {
+
+		dist := DiscreteGaussian{Sigma: DefaultSigma, Bound: DefaultBound}
+
+		samplerCT := NewGaussianSamplerCT(tc.prng, tc.ringQ, dist, false)
+
+		noiseBound := uint64(dist.Bound)
+
+		pol := samplerCT.ReadNew()
+
+		for i := 0; i < N; i++ {
+			for j, s := range tc.ringQ.SubRings {
+				require.False(t, noiseBound < pol.Coeffs[j][i] && pol.Coeffs[j][i] < (s.Modulus-noiseBound))
+			}
+		}
+
+		require.InDelta(t, math.Log2(DefaultSigma), tc.ringQ.Log2OfStandardDeviation(pol), 1)
+	})
+
 	for _, p := range []float64{.5, 1. / 3., 128. / 65536.} {
 		t.Run(testString(fmt.Sprintf("Sampler/Ternary/p=%1.2f", p), tc.ringQ), func(t *testing.T) {
 
@@ -669,6 +774,222 @@ func testModularReduction(tc *testParams, t *testing.T) {
 	})
 }
 
+func testConstantTimeReduction(tc *testParams, t *testing.T) {
+
+	t.Run(testString("ModularReduction/ConstantTime", tc.ringQ), func(t *testing.T) {
+
+		for _, q := range tc.ringQ.ModuliChain() {
+
+			// CRedConstantTime must agree with CRed, including on the boundary values.
+			for _, a := range []uint64{0, 1, q - 1, q, q + 1, 2*q - 2, 2*q - 1} {
+				require.Equalf(t, CRed(a, q), CRedConstantTime(a, q), "a = %v, q = %v", a, q)
+			}
+		}
+
+		ringQ := tc.ringQ
+
+		polWant := tc.uniformSamplerQ.ReadNew()
+		polHave := ringQ.NewPoly()
+
+		for i, qi := range ringQ.ModuliChain() {
+			ringQ.SubRings[i].AddScalarLazy(polWant.Coeffs[i], qi-1, polHave.Coeffs[i]) // pushes coefficients into [0, 2*qi-2]
+		}
+
+		polReduced := ringQ.NewPoly()
+		polConstantTime := ringQ.NewPoly()
+
+		ringQ.Reduce(polHave, polReduced)
+		ringQ.ReduceConstantTime(polHave, polConstantTime)
+
+		require.True(t, ringQ.Equal(polReduced, polConstantTime))
+	})
+
+	t.Run(testString("ModularReduction/ConstantTime/Timing", tc.ringQ), func(t *testing.T) {
+
+		if testing.Short() {
+			t.Skip("skipping timing-variance test in short mode")
+		}
+
+		q := tc.ringQ.ModuliChain()[0]
+
+		// below does not require a conditional subtraction in CRed, above always does:
+		// a timing leak on the branch would show up as a measurable difference between
+		// the two loops' durations, whereas CRedConstantTime should take the same time
+		// on both regardless of branch prediction or cache state.
+		below := make([]uint64, 1<<16)
+		above := make([]uint64, 1<<16)
+		for i := range below {
+			below[i] = q - 1
+			above[i] = 2*q - 1
+		}
+
+		run := func(values []uint64) time.Duration {
+			start := time.Now()
+			for rep := 0; rep < 64; rep++ {
+				for _, v := range values {
+					_ = CRedConstantTime(v, q)
+				}
+			}
+			return time.Since(start)
+		}
+
+		// warm-up, to avoid attributing cold-cache/JIT-like effects to the branch itself.
+		run(below)
+		run(above)
+
+		durBelow := run(below)
+		durAbove := run(above)
+
+		ratio := float64(durAbove) / float64(durBelow)
+
+		// a data-dependent branch on a modern CPU would typically show a much larger
+		// skew than this; the bound is kept loose to avoid flaking on noisy CI machines.
+		require.InDeltaf(t, 1.0, ratio, 0.5, "CRedConstantTime took %v below q and %v above q", durBelow, durAbove)
+	})
+}
+
+func testMulCoeffsBarrett(tc *testParams, t *testing.T) {
+
+	t.Run(testString("MulCoeffs/BarrettVsMontgomery", tc.ringQ), func(t *testing.T) {
+
+		ringQ := tc.ringQ
+
+		p0 := tc.uniformSamplerQ.ReadNew()
+		p1 := tc.uniformSamplerQ.ReadNew()
+
+		// MulCoeffsBarrett multiplies two polynomials given in the standard domain.
+		barrett := ringQ.NewPoly()
+		ringQ.MulCoeffsBarrett(p0, p1, barrett)
+
+		// MulCoeffsMontgomery expects its second operand in the Montgomery domain, so p1
+		// must first be switched into it for the two to compute the same product.
+		p1Mont := ringQ.NewPoly()
+		ringQ.MForm(p1, p1Mont)
+
+		montgomery := ringQ.NewPoly()
+		ringQ.MulCoeffsMontgomery(p0, p1Mont, montgomery)
+
+		require.True(t, ringQ.Equal(barrett, montgomery))
+	})
+}
+
+func testMulCoeffsMontgomeryThenAddVec(tc *testParams, t *testing.T) {
+
+	t.Run(testString("MulCoeffs/MontgomeryThenAddVec", tc.ringQ), func(t *testing.T) {
+
+		ringQ := tc.ringQ
+
+		const K = 5
+
+		a := make([]Poly, K)
+		b := make([]Poly, K)
+		for k := 0; k < K; k++ {
+			a[k] = tc.uniformSamplerQ.ReadNew()
+			b[k] = tc.uniformSamplerQ.ReadNew()
+		}
+
+		want := ringQ.NewPoly()
+		for k := 0; k < K; k++ {
+			ringQ.MulCoeffsMontgomeryThenAdd(a[k], b[k], want)
+		}
+
+		have := ringQ.NewPoly()
+		ringQ.MulCoeffsMontgomeryThenAddVec(a, b, have)
+
+		require.True(t, ringQ.Equal(want, have))
+	})
+}
+
+func testPolyEqualAndHash(tc *testParams, t *testing.T) {
+
+	t.Run(testString("Poly/EqualAndHash", tc.ringQ), func(t *testing.T) {
+
+		p0 := tc.uniformSamplerQ.ReadNew()
+		p1 := *p0.CopyNew()
+
+		require.True(t, p0.Equal(&p1))
+		require.Equal(t, p0.Hash(), p1.Hash())
+
+		p2 := tc.uniformSamplerQ.ReadNew()
+		require.False(t, p0.Equal(&p2))
+		require.NotEqual(t, p0.Hash(), p2.Hash())
+
+		// Polynomials at different levels must never be reported equal, even if their
+		// coefficients agree on the shared levels.
+		p3 := *p0.CopyNew()
+		p3.Resize(p3.Level() - 1)
+		require.False(t, p0.Equal(&p3))
+	})
+}
+
+func testPolyCopyLvl(tc *testParams, t *testing.T) {
+
+	t.Run(testString("Poly/CopyLvl", tc.ringQ), func(t *testing.T) {
+
+		src := tc.uniformSamplerQ.ReadNew()
+
+		// dst is preallocated once and reused across both CopyLvl calls below, confirming that
+		// CopyLvl, unlike Copy, never needs to Resize (and so never reallocates) its receiver.
+		dst := tc.ringQ.NewPoly()
+
+		level := src.Level() - 1
+		dst.CopyLvl(level, src)
+
+		for i := 0; i <= level; i++ {
+			require.Equal(t, src.Coeffs[i], dst.Coeffs[i])
+		}
+
+		// The level above the one copied must be left untouched.
+		require.NotEqual(t, src.Coeffs[level+1], dst.Coeffs[level+1])
+
+		dst.CopyLvl(src.Level(), src)
+		require.True(t, tc.ringQ.Equal(src, dst))
+	})
+}
+
+func testCompatibleWith(tc *testParams, t *testing.T) {
+
+	t.Run(testString("CompatibleWith", tc.ringQ), func(t *testing.T) {
+
+		ringQ := tc.ringQ
+
+		ringQSame, err := NewRing(ringQ.N(), ringQ.ModuliChain())
+		require.NoError(t, err)
+		require.True(t, ringQ.CompatibleWith(ringQSame))
+		require.True(t, ringQSame.CompatibleWith(ringQ))
+
+		ringQSmallerN, err := NewRing(ringQ.N()>>1, ringQ.ModuliChain())
+		require.NoError(t, err)
+		require.False(t, ringQ.CompatibleWith(ringQSmallerN))
+
+		differentModuli := append([]uint64{}, ringQ.ModuliChain()...)
+		ringQDifferentModuli, err := NewRing(ringQ.N(), differentModuli[:len(differentModuli)-1])
+		require.NoError(t, err)
+		require.False(t, ringQ.CompatibleWith(ringQDifferentModuli))
+	})
+}
+
+func testPolyPool(tc *testParams, t *testing.T) {
+
+	t.Run(testString("PolyPool", tc.ringQ), func(t *testing.T) {
+
+		pool := NewPolyPool(tc.ringQ)
+
+		p0 := pool.Get()
+		require.Equal(t, tc.ringQ.N(), p0.N())
+		require.Equal(t, tc.ringQ.Level(), p0.Level())
+
+		tc.uniformSamplerQ.Read(p0)
+		pool.Put(p0)
+
+		// A reused Poly must come back with the same dimensions as a freshly allocated one,
+		// even though its coefficients still carry whatever its previous owner wrote to it.
+		p1 := pool.Get()
+		require.Equal(t, tc.ringQ.N(), p1.N())
+		require.Equal(t, tc.ringQ.Level(), p1.Level())
+	})
+}
+
 func testMForm(tc *testParams, t *testing.T) {
 
 	t.Run(testString("MForm", tc.ringQ), func(t *testing.T) {
@@ -704,6 +1025,42 @@ func testMulScalarBigint(tc *testParams, t *testing.T) {
 	})
 }
 
+func testPowScalar(tc *testParams, t *testing.T) {
+
+	t.Run(testString("PowScalar", tc.ringQ), func(t *testing.T) {
+
+		polWant := tc.uniformSamplerQ.ReadNew()
+		polTest := tc.ringQ.NewPoly()
+
+		exp := uint64(17)
+
+		tc.ringQ.PowScalar(polWant, exp, polTest)
+
+		tmp := new(big.Int)
+		bigExp := new(big.Int).SetUint64(exp)
+		qi := new(big.Int)
+
+		for i, s := range tc.ringQ.SubRings[:tc.ringQ.Level()+1] {
+
+			qi.SetUint64(s.Modulus)
+
+			for j, c := range polWant.Coeffs[i] {
+				tmp.SetUint64(c)
+				tmp.Exp(tmp, bigExp, qi)
+				require.Equal(t, tmp.Uint64(), polTest.Coeffs[i][j])
+			}
+		}
+
+		// exp=0 must raise every coefficient to 1, regardless of its value.
+		tc.ringQ.PowScalar(polWant, 0, polTest)
+		for i := range tc.ringQ.SubRings[:tc.ringQ.Level()+1] {
+			for j := range polTest.Coeffs[i] {
+				require.Equal(t, uint64(1), polTest.Coeffs[i][j])
+			}
+		}
+	})
+}
+
 func testExtendBasis(tc *testParams, t *testing.T) {
 
 	N := tc.ringQ.N()
@@ -0,0 +1,162 @@
+package ring
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/tuneinsight/lattigo/v5/utils/sampling"
+)
+
+// GaussianSamplerCT is a fixed-PRNG-read-count variant of GaussianSampler.
+//
+// GaussianSampler.normFloat64 uses the Ziggurat algorithm, whose rejection loop performs a
+// secret-value-dependent number of PRNG reads. GaussianSamplerCT instead derives standard normal
+// samples with the Box-Muller transform, which consumes exactly two uniform draws per pair of outputs
+// and never loops:
+//
+//	z0 = sqrt(-2*ln(u1)) * cos(2*pi*u2)
+//	z1 = sqrt(-2*ln(u1)) * sin(2*pi*u2)
+//
+// The one data-dependent branch left in the PRNG-read count is enforcing X.Bound: instead of rejecting
+// and resampling values outside [-Bound, Bound] as GaussianSampler does, GaussianSamplerCT saturates
+// them to +-Bound. For the bound/sigma ratios this library uses (several sigma), the probability of
+// ever taking that branch is negligible, and the bias it introduces (folding the discarded tail
+// probability mass onto the two boundary values instead of discarding it) is correspondingly negligible.
+//
+// GaussianSamplerCT only removes the secret-dependent PRNG-read count, not the sampler's execution
+// time as a whole: it is NOT a constant-time or side-channel-resistant sampler. It evaluates
+// math.Sqrt/math.Log/math.Sin/math.Cos on every coefficient, and Go's standard library does not
+// specify nor guarantee that these run in constant time — on most platforms their real
+// implementations include input-dependent range reduction and branches. A deployment that needs an
+// actual timing guarantee on the sampled noise magnitude should use a CDT- or Knuth-Yao-based discrete
+// Gaussian sampler instead, which avoids floating-point transcendentals in the hot path entirely;
+// GaussianSamplerCT does not provide that guarantee and should not be relied upon for it.
+//
+// Unlike GaussianSampler, GaussianSamplerCT does not have a separate arbitrary-precision path for
+// sigma beyond float64's range (GaussianSampler switches to one above Sigma > 2^53): it always computes
+// in float64, which is the intended regime for noise distributions used by this library.
+type GaussianSamplerCT struct {
+	baseSampler
+	xe            DiscreteGaussian
+	randomBufferN []byte
+	montgomery    bool
+}
+
+// NewGaussianSamplerCT creates a new instance of GaussianSamplerCT from a PRNG, a ring definition and
+// the truncated Gaussian distribution parameters. Sigma is the desired standard deviation and bound is
+// the maximum coefficient norm in absolute value.
+func NewGaussianSamplerCT(prng sampling.PRNG, baseRing *Ring, X DiscreteGaussian, montgomery bool) (g *GaussianSamplerCT) {
+	g = new(GaussianSamplerCT)
+	g.prng = prng
+	g.baseRing = baseRing
+	g.xe = X
+	g.montgomery = montgomery
+	g.randomBufferN = make([]byte, 8*baseRing.N())
+	return
+}
+
+// AtLevel returns an instance of the target GaussianSamplerCT that operates at the target level.
+// This instance is not thread safe and cannot be used concurrently to the base instance.
+func (g *GaussianSamplerCT) AtLevel(level int) Sampler {
+	return &GaussianSamplerCT{
+		baseSampler:   g.baseSampler.AtLevel(level),
+		randomBufferN: g.randomBufferN,
+		xe:            g.xe,
+		montgomery:    g.montgomery,
+	}
+}
+
+// Read samples a truncated Gaussian polynomial on "pol" at the maximum level in the default ring, standard deviation and bound.
+func (g *GaussianSamplerCT) Read(pol Poly) {
+	g.read(pol, func(a, b, c uint64) uint64 {
+		return b
+	})
+}
+
+// ReadNew samples a new truncated Gaussian polynomial at the maximum level in the default ring, standard deviation and bound.
+func (g *GaussianSamplerCT) ReadNew() (pol Poly) {
+	pol = g.baseRing.NewPoly()
+	g.Read(pol)
+	return pol
+}
+
+// ReadAndAdd samples a truncated Gaussian polynomial at the given level for the receiver's default standard deviation and bound and adds it on "pol".
+func (g *GaussianSamplerCT) ReadAndAdd(pol Poly) {
+	g.read(pol, func(a, b, c uint64) uint64 {
+		return CRed(a+b, c)
+	})
+}
+
+func (g *GaussianSamplerCT) read(pol Poly, f func(a, b, c uint64) uint64) {
+
+	r := g.baseRing
+	level := r.level
+	N := r.N()
+
+	if _, err := g.prng.Read(g.randomBufferN); err != nil {
+		// Sanity check, this error should not happen.
+		panic(err)
+	}
+
+	moduli := r.ModuliChain()[:level+1]
+
+	bound := g.xe.Bound
+	sigma := g.xe.Sigma
+
+	coeffs := pol.Coeffs
+
+	for i := 0; i < N; i += 2 {
+
+		u1 := uniformFloat01(g.randomBufferN, i*8)
+		u2 := uniformFloat01(g.randomBufferN, (i+1)*8)
+
+		radius := math.Sqrt(-2 * math.Log(u1))
+		angle := 2 * math.Pi * u2
+
+		z0 := radius * math.Cos(angle) * sigma
+		z1 := radius * math.Sin(angle) * sigma
+
+		// Negligible-probability clamp: see the GaussianSamplerCT doc comment.
+		if z0 > bound {
+			z0 = bound
+		} else if z0 < -bound {
+			z0 = -bound
+		}
+
+		if i+1 < N {
+			if z1 > bound {
+				z1 = bound
+			} else if z1 < -bound {
+				z1 = -bound
+			}
+		}
+
+		for j, qi := range moduli {
+			coeffs[j][i] = f(coeffs[j][i], signedFloatToModulus(z0, qi), qi)
+			if i+1 < N {
+				coeffs[j][i+1] = f(coeffs[j][i+1], signedFloatToModulus(z1, qi), qi)
+			}
+		}
+	}
+
+	if g.montgomery {
+		g.baseRing.MForm(pol, pol)
+	}
+}
+
+// uniformFloat01 decodes the 8 bytes of buf starting at offset into a float64 strictly between 0 and 1.
+func uniformFloat01(buf []byte, offset int) float64 {
+	x := binary.BigEndian.Uint64(buf[offset : offset+8])
+	// x+1 ranges over [1, 2^64], so the result is always in (0, 1), never exactly 0 (which
+	// math.Log cannot take) or 1.
+	return (float64(x) + 1) / (float64(math.MaxUint64) + 2)
+}
+
+// signedFloatToModulus rounds v to the nearest integer and reduces it modulo qi.
+func signedFloatToModulus(v float64, qi uint64) uint64 {
+	r := int64(math.Round(v))
+	if r < 0 {
+		return qi - uint64(-r)
+	}
+	return uint64(r)
+}
@@ -0,0 +1,104 @@
+package ring
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/tuneinsight/lattigo/v5/utils/sampling"
+)
+
+// UniformSamplerCT is a constant-time variant of UniformSampler.
+//
+// UniformSampler.read draws a coefficient by rejection sampling: it repeatedly reads a masked random
+// value until one falls in [0, qi-1], which means the number of PRNG reads it performs depends on the
+// sampled values themselves. UniformSamplerCT instead draws every coefficient by reducing a uniformly
+// random 128-bit integer modulo qi, which always consumes exactly 16 bytes of PRNG output per
+// coefficient per modulus, regardless of the outcome.
+//
+// This trades a small, fixed bias for that guarantee: reducing a uniform 128-bit integer modulo qi
+// over-represents the residues below (2^128 mod qi) by one occurrence out of 2^128, i.e. a statistical
+// distance of at most qi/2^128 from the uniform distribution over Z_qi. For every modulus size used in
+// this library (qi < 2^62), this is many orders of magnitude smaller than, e.g., the statistical
+// distance already introduced by drawing the PRNG's own seed from a finite-entropy source, and is not
+// detectable by any computationally bounded distinguisher.
+//
+// Use UniformSamplerCT only where resistance to secret-dependent PRNG-read counts is required: it
+// reads twice as many PRNG bytes per coefficient as UniformSampler's common case, and replaces a
+// comparison with a 128-bit by 64-bit division.
+type UniformSamplerCT struct {
+	baseSampler
+	randomBufferN []byte
+}
+
+// NewUniformSamplerCT creates a new instance of UniformSamplerCT from a PRNG and ring definition.
+func NewUniformSamplerCT(prng sampling.PRNG, baseRing *Ring) (u *UniformSamplerCT) {
+	u = new(UniformSamplerCT)
+	u.baseRing = baseRing
+	u.prng = prng
+	u.randomBufferN = make([]byte, 16*baseRing.N())
+	return
+}
+
+// AtLevel returns an instance of the target UniformSamplerCT to sample at the given level.
+// The returned sampler cannot be used concurrently to the original sampler.
+func (u *UniformSamplerCT) AtLevel(level int) Sampler {
+	return &UniformSamplerCT{
+		baseSampler:   u.baseSampler.AtLevel(level),
+		randomBufferN: u.randomBufferN,
+	}
+}
+
+func (u *UniformSamplerCT) Read(pol Poly) {
+	u.read(pol, func(a, b, c uint64) uint64 {
+		return b
+	})
+}
+
+func (u *UniformSamplerCT) ReadAndAdd(pol Poly) {
+	u.read(pol, func(a, b, c uint64) uint64 {
+		return CRed(a+b, c)
+	})
+}
+
+func (u *UniformSamplerCT) read(pol Poly, f func(a, b, c uint64) uint64) {
+
+	level := u.baseRing.Level()
+	N := u.baseRing.N()
+	buffer := u.randomBufferN
+
+	for j := 0; j < level+1; j++ {
+
+		// Independent randomness per modulus: reusing the high bits of the same 128-bit draw
+		// across moduli would correlate their residues.
+		if _, err := u.prng.Read(buffer); err != nil {
+			// Sanity check, this error should not happen.
+			panic(err)
+		}
+
+		subRing := u.baseRing.SubRings[j]
+		qi := subRing.Modulus
+		brc := subRing.BRedConstant
+
+		coeffs := pol.Coeffs[j]
+
+		for i, ptr := 0, 0; i < N; i, ptr = i+1, ptr+16 {
+
+			hi := BRedAddConstantTime(binary.BigEndian.Uint64(buffer[ptr:ptr+8]), qi, brc)
+			lo := binary.BigEndian.Uint64(buffer[ptr+8 : ptr+16])
+
+			// Remainder of the 128-bit value (hi*2^64 + lo) modulo qi, with hi already reduced
+			// below qi so that the division is valid.
+			_, randomUint := bits.Div64(hi, lo, qi)
+
+			coeffs[i] = f(coeffs[i], randomUint, qi)
+		}
+	}
+}
+
+// ReadNew generates a new polynomial with coefficients following a uniform distribution over [0, Qi-1].
+// Polynomial is created at the max level.
+func (u *UniformSamplerCT) ReadNew() (pol Poly) {
+	pol = u.baseRing.NewPoly()
+	u.Read(pol)
+	return
+}
@@ -81,6 +81,10 @@ func (s *SubRing) Type() Type {
 		return Standard
 	case NumberTheoreticTransformerConjugateInvariant:
 		return ConjugateInvariant
+	case *NumberTheoreticTransformerReference:
+		// NumberTheoreticTransformerReference computes the NTT of Z[X]/(X^N+1), the same
+		// cyclotomic ring as NumberTheoreticTransformerStandard, just for a non-power-of-two N.
+		return Standard
 	default:
 		// Sanity check
 		panic(fmt.Errorf("invalid NumberTheoreticTransformer type: %T", s.ntt))
@@ -232,6 +236,11 @@ func CheckPrimitiveRoot(g, q uint64, factors []uint64) (err error) {
 // subRingParametersLiteral is a struct to store the minimum information
 // to uniquely identify a SubRing and be able to reconstruct it efficiently.
 // This struct's purpose is to faciliate marshalling of SubRings.
+//
+// RootsForward, RootsBackward and NInv hold the precomputed NTT tables (the Montgomery-form
+// powers of the primitive root, and their inverses) of an already-initialized SubRing, so
+// that unmarshalling can restore them directly instead of regenerating them, which is the
+// dominant cost of NewSubRingWithCustomNTT for short-lived instantiations.
 type subRingParametersLiteral struct {
 	Type          uint8    // Standard or ConjugateInvariant
 	LogN          uint8    // Log2 of the ring degree
@@ -239,12 +248,22 @@ type subRingParametersLiteral struct {
 	Modulus       uint64   // Modulus
 	Factors       []uint64 // Factors of Modulus-1
 	PrimitiveRoot uint64   // Primitive root used
+	RootsForward  []uint64 // Precomputed forward NTT table (nttPsi), in Montgomery form
+	RootsBackward []uint64 // Precomputed backward NTT table (nttPsiInv), in Montgomery form
+	NInv          uint64   // Precomputed [N^-1] mod Modulus, in Montgomery form
 }
 
 // ParametersLiteral returns the SubRingParametersLiteral of the SubRing.
 func (s *SubRing) parametersLiteral() subRingParametersLiteral {
 	Factors := make([]uint64, len(s.Factors))
 	copy(Factors, s.Factors)
+
+	RootsForward := make([]uint64, len(s.RootsForward))
+	copy(RootsForward, s.RootsForward)
+
+	RootsBackward := make([]uint64, len(s.RootsBackward))
+	copy(RootsBackward, s.RootsBackward)
+
 	return subRingParametersLiteral{
 		Type:          uint8(s.Type()),
 		LogN:          uint8(bits.Len64(uint64(s.N - 1))),
@@ -252,6 +271,9 @@ func (s *SubRing) parametersLiteral() subRingParametersLiteral {
 		Modulus:       s.Modulus,
 		Factors:       Factors,
 		PrimitiveRoot: s.PrimitiveRoot,
+		RootsForward:  RootsForward,
+		RootsBackward: RootsBackward,
+		NInv:          s.NInv,
 	}
 }
 
@@ -304,5 +326,55 @@ func newSubRingFromParametersLiteral(p subRingParametersLiteral) (s *SubRing, er
 		return nil, fmt.Errorf("invalid ring type")
 	}
 
+	// If the precomputed NTT tables were included, and are consistent with the modulus,
+	// adopt them directly instead of paying for their regeneration, which is the part of
+	// generateNTTConstants that does not benefit from having PrimitiveRoot and Factors
+	// already set.
+	if len(p.RootsForward) != 0 || len(p.RootsBackward) != 0 {
+
+		if err = s.setNTTTables(p.RootsForward, p.RootsBackward, p.NInv); err != nil {
+			return nil, err
+		}
+
+		return s, nil
+	}
+
 	return s, s.generateNTTConstants()
 }
+
+// setNTTTables validates RootsForward, RootsBackward and NInv against s.Modulus and,
+// if they check out, installs them on s in place of regenerating them.
+func (s *SubRing) setNTTTables(RootsForward, RootsBackward []uint64, NInv uint64) (err error) {
+
+	n := int(s.NthRoot >> 1)
+
+	if len(RootsForward) != n || len(RootsBackward) != n {
+		return fmt.Errorf("invalid NTT tables: expected length %d, got %d forward and %d backward roots", n, len(RootsForward), len(RootsBackward))
+	}
+
+	one := MForm(1, s.Modulus, s.BRedConstant)
+
+	// Checks every index, by verifying that each forward root and its matching backward root
+	// multiply back, in Montgomery form, to Montgomery-form 1: the defining relation of the
+	// NTT table for this modulus. This catches a table generated for, or corrupted to no
+	// longer match, a different modulus, at any index, not just a handful of sampled ones.
+	for idx := 0; idx < n; idx++ {
+		if MRed(RootsForward[idx], RootsBackward[idx], s.Modulus, s.MRedConstant) != one {
+			return fmt.Errorf("invalid NTT tables: do not match modulus %d", s.Modulus)
+		}
+	}
+
+	if NInv != MForm(ModExp(s.NthRoot>>1, s.Modulus-2, s.Modulus), s.Modulus, s.BRedConstant) {
+		return fmt.Errorf("invalid NTT tables: NInv does not match modulus %d", s.Modulus)
+	}
+
+	s.RootsForward = make([]uint64, len(RootsForward))
+	copy(s.RootsForward, RootsForward)
+
+	s.RootsBackward = make([]uint64, len(RootsBackward))
+	copy(s.RootsBackward, RootsBackward)
+
+	s.NInv = NInv
+
+	return nil
+}
@@ -49,6 +49,16 @@ func (s *SubRing) ReduceLazy(p1, p2 []uint64) {
 	reducelazyvec(p1, p2, s.Modulus, s.BRedConstant)
 }
 
+// ReduceConstantTime evaluates p2 = p1 (mod modulus) in constant time, i.e. without a
+// data-dependent conditional subtraction. It is intended for security-sensitive settings
+// where the risk of a timing side-channel on the coefficients outweighs the small performance
+// cost over Reduce.
+// Iteration is done with respect to len(p1).
+// All input must have a size which is a multiple of 8.
+func (s *SubRing) ReduceConstantTime(p1, p2 []uint64) {
+	reduceconstanttimevec(p1, p2, s.Modulus, s.BRedConstant)
+}
+
 // MulCoeffsLazy evaluates p3 = p1*p2.
 // Iteration is done with respect to len(p1).
 // All input must have a size which is a multiple of 8.
@@ -112,6 +122,17 @@ func (s *SubRing) MulCoeffsMontgomeryThenAdd(p1, p2, p3 []uint64) {
 	mulcoeffsmontgomerythenaddvec(p1, p2, p3, s.Modulus, s.MRedConstant)
 }
 
+// MulCoeffsMontgomeryThenAddVec evaluates acc = acc + sum_k a[k]*b[k] (mod modulus), fusing the
+// accumulation of all K pairs into a single pass over acc. This is equivalent to calling
+// MulCoeffsMontgomeryThenAdd once per pair, but keeps acc's coefficients resident in cache across
+// all K products instead of reloading them from memory on every call; this matters when K is large,
+// e.g. the base decomposition loop of GadgetProduct.
+// a and b must have the same length K, and every slice in a, b and acc must have the same length,
+// a multiple of 8.
+func (s *SubRing) MulCoeffsMontgomeryThenAddVec(a, b [][]uint64, acc []uint64) {
+	mulcoeffsmontgomerythenaddvecmulti(a, b, acc, s.Modulus, s.MRedConstant)
+}
+
 // MulCoeffsMontgomeryThenAddLazy evaluates p3 = p3 + (p1*p2 (mod modulus)).
 // Iteration is done with respect to len(p1).
 // All input must have a size which is a multiple of 8.
@@ -166,6 +166,28 @@ func reducelazyvec(p1, p2 []uint64, modulus uint64, brc []uint64) {
 	}
 }
 
+func reduceconstanttimevec(p1, p2 []uint64, modulus uint64, brc []uint64) {
+
+	N := len(p1)
+
+	for j := 0; j < N; j = j + 8 {
+
+		/* #nosec G103 -- behavior and consequences well understood, possible buffer overflow if len(p1)%8 */
+		x := (*[8]uint64)(unsafe.Pointer(&p1[j]))
+		/* #nosec G103 -- behavior and consequences well understood, possible buffer overflow if len(p2)%8 */
+		z := (*[8]uint64)(unsafe.Pointer(&p2[j]))
+
+		z[0] = BRedAddConstantTime(x[0], modulus, brc)
+		z[1] = BRedAddConstantTime(x[1], modulus, brc)
+		z[2] = BRedAddConstantTime(x[2], modulus, brc)
+		z[3] = BRedAddConstantTime(x[3], modulus, brc)
+		z[4] = BRedAddConstantTime(x[4], modulus, brc)
+		z[5] = BRedAddConstantTime(x[5], modulus, brc)
+		z[6] = BRedAddConstantTime(x[6], modulus, brc)
+		z[7] = BRedAddConstantTime(x[7], modulus, brc)
+	}
+}
+
 func mulcoeffslazyvec(p1, p2, p3 []uint64) {
 
 	N := len(p1)
@@ -380,6 +402,38 @@ func mulcoeffsmontgomerythenaddvec(p1, p2, p3 []uint64, modulus, mrc uint64) {
 	}
 }
 
+// mulcoeffsmontgomerythenaddvecmulti evaluates acc = acc + sum_k a[k]*b[k] coefficient-wise, with
+// Montgomery reduction, accumulating all K pairs for a given coefficient block before moving to the
+// next block. This keeps acc's cache lines resident across all K products instead of streaming them
+// in and out of cache once per pair, which matters when K is large.
+func mulcoeffsmontgomerythenaddvecmulti(a, b [][]uint64, acc []uint64, modulus, mrc uint64) {
+
+	N := len(acc)
+
+	for j := 0; j < N; j = j + 8 {
+
+		/* #nosec G103 -- behavior and consequences well understood, possible buffer overflow if len(acc)%8 */
+		z := (*[8]uint64)(unsafe.Pointer(&acc[j]))
+
+		for k := range a {
+
+			/* #nosec G103 -- behavior and consequences well understood, possible buffer overflow if len(a[k])%8 */
+			x := (*[8]uint64)(unsafe.Pointer(&a[k][j]))
+			/* #nosec G103 -- behavior and consequences well understood, possible buffer overflow if len(b[k])%8 */
+			y := (*[8]uint64)(unsafe.Pointer(&b[k][j]))
+
+			z[0] = CRed(z[0]+MRed(x[0], y[0], modulus, mrc), modulus)
+			z[1] = CRed(z[1]+MRed(x[1], y[1], modulus, mrc), modulus)
+			z[2] = CRed(z[2]+MRed(x[2], y[2], modulus, mrc), modulus)
+			z[3] = CRed(z[3]+MRed(x[3], y[3], modulus, mrc), modulus)
+			z[4] = CRed(z[4]+MRed(x[4], y[4], modulus, mrc), modulus)
+			z[5] = CRed(z[5]+MRed(x[5], y[5], modulus, mrc), modulus)
+			z[6] = CRed(z[6]+MRed(x[6], y[6], modulus, mrc), modulus)
+			z[7] = CRed(z[7]+MRed(x[7], y[7], modulus, mrc), modulus)
+		}
+	}
+}
+
 func mulcoeffsmontgomerythenaddlazyvec(p1, p2, p3 []uint64, modulus, mrc uint64) {
 
 	N := len(p1)
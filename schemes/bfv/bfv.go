@@ -6,10 +6,14 @@ import (
 	"fmt"
 
 	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+	"github.com/tuneinsight/lattigo/v5/he"
 	"github.com/tuneinsight/lattigo/v5/ring"
 	"github.com/tuneinsight/lattigo/v5/schemes/bgv"
 )
 
+// Ensures that Evaluator complies to the he.EvaluatorWithRotations interface.
+var _ he.EvaluatorWithRotations = Evaluator{}
+
 // NewPlaintext allocates a new rlwe.Plaintext from the BFV parameters, at the
 // specified level. If the level argument is not provided, the plaintext is
 // initialized at level params.MaxLevelQ().
@@ -32,6 +36,14 @@ func NewPlaintext(params Parameters, level ...int) (pt *rlwe.Plaintext) {
 //
 // To create a ciphertext for encrypting a new message, the ciphertext should be
 // at degree 1.
+//
+// The returned ciphertext has IsNTT set to true: BFV parameters require NTTFlag
+// to be true (see NewParameters), so every BFV ciphertext is already stored and
+// operated on in the NTT domain between evaluator calls. Evaluator.MulRelin's
+// internal transforms in and out of the NTT domain are an unavoidable part of
+// its scale-invariant tensoring algorithm, which needs the coefficient domain to
+// round the result by t/Q, rather than a consequence of the ciphertext's storage
+// domain; there is no coefficient-domain representation to opt out of.
 func NewCiphertext(params Parameters, degree int, level ...int) (ct *rlwe.Ciphertext) {
 	ct = rlwe.NewCiphertext(params, degree, level...)
 	ct.IsBatched = true
@@ -58,6 +70,49 @@ func NewKeyGenerator(params Parameters) *rlwe.KeyGenerator {
 	return rlwe.NewKeyGenerator(params)
 }
 
+// Context bundles a set of BFV Parameters together with factory methods for the other BFV
+// structures (Encoder, Evaluator, KeyGenerator, Encryptor, Decryptor), mirroring the ergonomics
+// of the pre-v4 API's per-context constructors. It holds no state beyond params and is provided
+// as a migration convenience: NewContext(params).NewEncoder() is equivalent to NewEncoder(params),
+// and likewise for the other methods.
+type Context struct {
+	params Parameters
+}
+
+// NewContext creates a Context wrapping the given BFV parameters.
+func NewContext(params Parameters) Context {
+	return Context{params: params}
+}
+
+// NewEncoder creates a new Encoder from the Context's parameters.
+func (c Context) NewEncoder() *Encoder {
+	return NewEncoder(c.params)
+}
+
+// NewEvaluator creates a new Evaluator, that can be used to do homomorphic
+// operations on ciphertexts and/or plaintexts. It stores a memory buffer
+// and ciphertexts that will be used for intermediate values.
+func (c Context) NewEvaluator(evk rlwe.EvaluationKeySet) *Evaluator {
+	return NewEvaluator(c.params, evk)
+}
+
+// NewKeyGenerator creates a new rlwe.KeyGenerator from the Context's parameters.
+func (c Context) NewKeyGenerator() *rlwe.KeyGenerator {
+	return NewKeyGenerator(c.params)
+}
+
+// NewEncryptor instantiates a new rlwe.Encryptor from the Context's parameters and the given
+// encryption key. This key can be either a *rlwe.SecretKey or a *rlwe.PublicKey.
+func (c Context) NewEncryptor(key rlwe.EncryptionKey) *rlwe.Encryptor {
+	return NewEncryptor(c.params, key)
+}
+
+// NewDecryptor instantiates a new rlwe.Decryptor from the Context's parameters and the given
+// secret decryption key.
+func (c Context) NewDecryptor(key *rlwe.SecretKey) *rlwe.Decryptor {
+	return NewDecryptor(c.params, key)
+}
+
 // Encoder is a structure that stores the parameters to encode values on a plaintext in a SIMD (Single-Instruction Multiple-Data) fashion.
 type Encoder struct {
 	*bgv.Encoder
@@ -108,8 +163,20 @@ func (eval Evaluator) ShallowCopy() *Evaluator {
 //   - []uint64 or []int64 (of size at most N where N is the smallest integer satisfying PlaintextModulus = 1 mod 2N)
 //   - opOut: an *rlwe.Ciphertext
 //
+// When op1 is a *rlwe.Plaintext (e.g. allocated with NewPlaintext and filled with Encoder.Encode),
+// opOut.Degree() stays equal to op0.Degree(): the plaintext contributes no noise of its own, so the
+// multiplication, unlike a ciphertext-ciphertext Mul, never needs relinearization and only grows the
+// noise by the plaintext's norm instead of by the product of both operands' noise. The plaintext is
+// read directly in the NTT domain in which Encoder.Encode already leaves it, so no further domain
+// conversion is performed.
+//
 // The procedure will return an error if either op0 or op1 are have a degree higher than 1.
 // The procedure will return an error if opOut.Degree != op0.Degree + op1.Degree.
+//
+// The degree-2 opOut returned by a ciphertext-ciphertext Mul can be relinearized back down to
+// degree 1 at any later point, via the Relinearize method inherited from rlwe.Evaluator: this
+// lets callers batch several tensor products before paying for relinearization, or relinearize
+// with a key that was not yet available when the tensoring was performed.
 func (eval Evaluator) Mul(op0 *rlwe.Ciphertext, op1 rlwe.Operand, opOut *rlwe.Ciphertext) (err error) {
 	switch op1 := op1.(type) {
 	case rlwe.ElementInterface[ring.Poly], []uint64:
@@ -178,3 +245,62 @@ func (eval Evaluator) MulRelin(op0 *rlwe.Ciphertext, op1 rlwe.Operand, opOut *rl
 func (eval Evaluator) Rescale(op0, op1 *rlwe.Ciphertext) (err error) {
 	return nil
 }
+
+// EqualMask returns, for each slot, an encryption of 1 if a and b encrypt the same value modulo the
+// plaintext modulus t in that slot, and an encryption of 0 otherwise.
+//
+// This requires t to be prime: by Fermat's little theorem, x^{t-1} mod t is 1 for every x != 0 mod t
+// and 0 for x == 0 mod t, so letting d = a - b, the mask is 1 - d^{t-1}. d^{t-1} is computed by
+// square-and-multiply exponentiation using MulRelinNew, which in BFV's scale-invariant tensoring does
+// not consume levels, but the noise grows multiplicatively with the depth of the exponentiation, so
+// EqualMask is only practical for plaintext moduli t small enough that ceil(log2(t-1)) multiplications
+// fit within the ciphertext's noise budget. The receiver Evaluator must have been created with a
+// rlwe.RelinearizationKey (see WithKey).
+func (eval Evaluator) EqualMask(a, b *rlwe.Ciphertext) (mask *rlwe.Ciphertext, err error) {
+
+	t := eval.GetParameters().PlaintextModulus()
+
+	if t < 3 {
+		return nil, fmt.Errorf("cannot EqualMask: plaintext modulus t=%d must be an odd prime greater than 2", t)
+	}
+
+	base, err := eval.SubNew(a, b)
+	if err != nil {
+		return nil, fmt.Errorf("cannot EqualMask: %w", err)
+	}
+
+	var pow *rlwe.Ciphertext
+	for exp := t - 1; exp > 0; exp >>= 1 {
+
+		if exp&1 == 1 {
+			if pow == nil {
+				pow = base.CopyNew()
+			} else if pow, err = eval.MulRelinNew(pow, base); err != nil {
+				return nil, fmt.Errorf("cannot EqualMask: %w", err)
+			}
+		}
+
+		if exp > 1 {
+			if base, err = eval.MulRelinNew(base, base); err != nil {
+				return nil, fmt.Errorf("cannot EqualMask: %w", err)
+			}
+		}
+	}
+
+	// MulNew and AddNew by a scalar operate directly on the underlying ring elements and leave
+	// opOut.Scale at its freshly-allocated default, instead of propagating pow.Scale the way the
+	// ElementInterface tensoring above does; negating and incrementing a ciphertext by a plaintext
+	// constant doesn't change the scale it was encrypted under, so that scale is restored by hand.
+	negPow, err := eval.MulNew(pow, int64(-1))
+	if err != nil {
+		return nil, fmt.Errorf("cannot EqualMask: %w", err)
+	}
+	negPow.Scale = pow.Scale
+
+	if mask, err = eval.AddNew(negPow, int64(1)); err != nil {
+		return nil, fmt.Errorf("cannot EqualMask: %w", err)
+	}
+	mask.Scale = negPow.Scale
+
+	return mask, nil
+}
@@ -290,6 +290,25 @@ func benchEvaluator(tc *testContext, b *testing.B) {
 		}
 	})
 
+	// benchMulRelinChainDepth is the chain length benchmarked below. BFV ciphertexts are always
+	// stored in the NTT domain (see NewCiphertext), and MulRelinScaleInvariant's tensoring does
+	// not consume levels, only noise budget, so a long chain of multiplications can be run at a
+	// single level without needing deeper (and slower to generate) benchmark parameters.
+	const benchMulRelinChainDepth = 20
+
+	b.Run(GetBenchName(params, fmt.Sprintf("Evaluator/MulRelinChain/Depth=%d", benchMulRelinChainDepth)), func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			ct := ciphertext1.CopyNew()
+			for j := 0; j < benchMulRelinChainDepth; j++ {
+				if err := eval.MulRelin(ct, ciphertext2, ct); err != nil {
+					b.Log(err)
+					b.Fail()
+				}
+			}
+		}
+	})
+
 	b.Run(GetBenchName(params, "Evaluator/Rotate"), func(b *testing.B) {
 		gk := tc.kgen.GenGaloisKeyNew(5, tc.sk)
 		evk := rlwe.NewMemEvaluationKeySet(nil, gk)
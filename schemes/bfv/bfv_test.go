@@ -59,8 +59,11 @@ func TestBFV(t *testing.T) {
 
 			for _, testSet := range []func(tc *testContext, t *testing.T){
 				testParameters,
+				testContextHelper,
 				testEncoder,
+				testSignedSubtraction,
 				testEvaluator,
+				testEqualMask,
 			} {
 				testSet(tc, t)
 				runtime.GC()
@@ -202,6 +205,29 @@ func testParameters(tc *testContext, t *testing.T) {
 	})
 }
 
+// testContextHelper checks that the Context migration helper's factory methods behave the same as the
+// free-function constructors they wrap.
+func testContextHelper(tc *testContext, t *testing.T) {
+	t.Run(GetTestName("Context", tc.params, 0), func(t *testing.T) {
+
+		ctx := NewContext(tc.params)
+
+		sk := ctx.NewKeyGenerator().GenSecretKeyNew()
+		encryptor := ctx.NewEncryptor(sk)
+		decryptor := ctx.NewDecryptor(sk)
+
+		coeffs, _, ciphertext := newTestVectorsLvl(tc.params.MaxLevel(), tc.params.DefaultScale(), tc, encryptor)
+
+		plaintext := decryptor.DecryptNew(ciphertext)
+
+		have := make([]uint64, tc.params.MaxSlots())
+		require.NoError(t, ctx.NewEncoder().Decode(plaintext, have))
+		require.True(t, utils.EqualSlice(coeffs.Coeffs[0], have))
+
+		require.NotNil(t, ctx.NewEvaluator(nil))
+	})
+}
+
 func testEncoder(tc *testContext, t *testing.T) {
 
 	for _, lvl := range tc.testLevel {
@@ -236,6 +262,44 @@ func testEncoder(tc *testContext, t *testing.T) {
 	}
 }
 
+// testSignedSubtraction encodes signed integers with Encoder.Encode/Decode's centered []int64
+// convention (negative values map to T-|v|, decoded back to the signed range [-T/2, T/2)), and
+// checks that a homomorphic subtraction producing a negative result decodes to the correct signed
+// integer.
+func testSignedSubtraction(tc *testContext, t *testing.T) {
+
+	for _, lvl := range tc.testLevel {
+		t.Run(GetTestName("Encoder/Int/Sub", tc.params, lvl), func(t *testing.T) {
+
+			values0 := make([]int64, tc.params.MaxSlots())
+			values1 := make([]int64, tc.params.MaxSlots())
+			want := make([]int64, tc.params.MaxSlots())
+			for i := range values0 {
+				values0[i] = int64(i)
+				values1[i] = int64(i) + 1
+				want[i] = values0[i] - values1[i] // always -1: an exact, easily-readable negative result
+			}
+
+			plaintext0 := NewPlaintext(tc.params, lvl)
+			require.NoError(t, tc.encoder.Encode(values0, plaintext0))
+			ciphertext0, err := tc.encryptorSk.EncryptNew(plaintext0)
+			require.NoError(t, err)
+
+			plaintext1 := NewPlaintext(tc.params, lvl)
+			require.NoError(t, tc.encoder.Encode(values1, plaintext1))
+			ciphertext1, err := tc.encryptorSk.EncryptNew(plaintext1)
+			require.NoError(t, err)
+
+			require.NoError(t, tc.evaluator.Sub(ciphertext0, ciphertext1, ciphertext0))
+
+			have := make([]int64, tc.params.MaxSlots())
+			require.NoError(t, tc.encoder.Decode(tc.decryptor.DecryptNew(ciphertext0), have))
+
+			require.True(t, utils.EqualSlice(want, have))
+		})
+	}
+}
+
 func testEvaluator(tc *testContext, t *testing.T) {
 
 	t.Run("Evaluator", func(t *testing.T) {
@@ -373,6 +437,35 @@ func testEvaluator(tc *testContext, t *testing.T) {
 			})
 		}
 
+		for _, lvl := range tc.testLevel {
+			t.Run(GetTestName("Mul/Ct/Ct/Relinearize", tc.params, lvl), func(t *testing.T) {
+
+				if lvl == 0 {
+					t.Skip("Level = 0")
+				}
+
+				// Mul leaves its degree-2 output unrelinearized, and Relinearize is promoted
+				// from rlwe.Evaluator onto bfv.Evaluator, so tensoring and relinearization can
+				// be driven as two separate steps, e.g. to batch several tensor products before
+				// relinearizing each result once.
+				values0, _, ciphertext0 := newTestVectorsLvl(lvl, tc.params.NewScale(3), tc, tc.encryptorSk)
+				values1, _, ciphertext1 := newTestVectorsLvl(lvl, tc.params.NewScale(7), tc, tc.encryptorSk)
+
+				tc.ringT.MulCoeffsBarrett(values0, values1, values0)
+
+				tensored := NewCiphertext(tc.params, 2, lvl)
+
+				require.NoError(t, tc.evaluator.Mul(ciphertext0, ciphertext1, tensored))
+
+				receiver := NewCiphertext(tc.params, 1, lvl)
+
+				require.NoError(t, tc.evaluator.Relinearize(tensored, receiver))
+
+				verifyTestVectors(tc, tc.decryptor, values0, receiver, t)
+
+			})
+		}
+
 		for _, lvl := range tc.testLevel {
 			t.Run(GetTestName("Mul/Ct/Pt/Inplace", tc.params, lvl), func(t *testing.T) {
 
@@ -542,3 +635,69 @@ func testEvaluator(tc *testContext, t *testing.T) {
 		}
 	})
 }
+
+// testEqualMaskPlaintextModulus is a small prime plaintext modulus for which EqualMask's
+// square-and-multiply exponentiation only needs 8 sequential multiplications (t-1 = 256 = 2^8).
+const testEqualMaskPlaintextModulus = 257
+
+// testEqualMaskParams provides a much deeper modulus chain than testInsecure: EqualMask performs
+// ceil(log2(t-1)) sequential relinearizing multiplications without ever rescaling in between, so it
+// needs far more noise budget than the single-multiplication circuits the rest of this test file
+// exercises.
+var testEqualMaskParams = ParametersLiteral{
+	LogN:             10,
+	LogQ:             []int{44, 44, 44, 44, 44, 44, 44, 44, 44, 44},
+	LogP:             []int{44},
+	PlaintextModulus: testEqualMaskPlaintextModulus,
+}
+
+func testEqualMask(tc *testContext, t *testing.T) {
+
+	if tc.params.PlaintextModulus() != testPlaintextModulus[0] {
+		// testEqualMaskParams is independent of the (params, plaintextModulus) combination under
+		// test, so only run it once instead of once per combination exercised by TestBFV.
+		return
+	}
+
+	params, err := NewParametersFromLiteral(testEqualMaskParams)
+	require.NoError(t, err)
+
+	tc, err = genTestParams(params)
+	require.NoError(t, err)
+
+	lvl := tc.params.MaxLevel()
+
+	t.Run(GetTestName("Evaluator/EqualMask", tc.params, lvl), func(t *testing.T) {
+
+		valuesA, _, ciphertextA := newTestVectorsLvl(lvl, tc.params.DefaultScale(), tc, tc.encryptorSk)
+		valuesB := tc.uSampler.ReadNew()
+
+		// forces a known, partial overlap between valuesA and valuesB instead of the
+		// all-equal values that newTestVectorsLvl alone would produce.
+		for i := range valuesA.Coeffs[0] {
+			if i%2 == 0 {
+				valuesB.Coeffs[0][i] = valuesA.Coeffs[0][i]
+			}
+		}
+
+		// ground truth is computed mod the plaintext modulus, since that is the domain EqualMask
+		// compares in: even though valuesB was only forced to equal valuesA at even indices, it is
+		// uniformly random at odd indices and may coincidentally land on the same residue.
+		valuesMask := make([]uint64, len(valuesA.Coeffs[0]))
+		for i := range valuesA.Coeffs[0] {
+			if valuesA.Coeffs[0][i]%testEqualMaskPlaintextModulus == valuesB.Coeffs[0][i]%testEqualMaskPlaintextModulus {
+				valuesMask[i] = 1
+			}
+		}
+
+		plaintextB := NewPlaintext(tc.params, lvl)
+		require.NoError(t, tc.encoder.Encode(valuesB.Coeffs[0], plaintextB))
+		ciphertextB, err := tc.encryptorSk.EncryptNew(plaintextB)
+		require.NoError(t, err)
+
+		mask, err := tc.evaluator.EqualMask(ciphertextA, ciphertextB)
+		require.NoError(t, err)
+
+		verifyTestVectors(tc, tc.decryptor, ring.Poly{Coeffs: [][]uint64{valuesMask}}, mask, t)
+	})
+}
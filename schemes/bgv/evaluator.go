@@ -6,11 +6,15 @@ import (
 	"math/big"
 
 	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+	"github.com/tuneinsight/lattigo/v5/he"
 	"github.com/tuneinsight/lattigo/v5/ring"
 	"github.com/tuneinsight/lattigo/v5/ring/ringqp"
 	"github.com/tuneinsight/lattigo/v5/utils"
 )
 
+// Ensures that Evaluator complies to the he.EvaluatorWithRotations interface.
+var _ he.EvaluatorWithRotations = Evaluator{}
+
 // Evaluator is a struct that holds the necessary elements to perform the homomorphic operations between ciphertexts and/or plaintexts.
 // It also holds a memory buffer used to store intermediate computations.
 type Evaluator struct {
@@ -3,6 +3,9 @@
 package ckks
 
 import (
+	"fmt"
+	"math/big"
+
 	"github.com/tuneinsight/lattigo/v5/core/rlwe"
 )
 
@@ -63,6 +66,67 @@ func NewDecryptor(params Parameters, key *rlwe.SecretKey) *rlwe.Decryptor {
 	return rlwe.NewDecryptor(params, key)
 }
 
+// DecryptToCoeffs decrypts ct and returns the centered coefficients of its underlying plaintext
+// polynomial, in the standard (non-NTT) domain and divided by ct.Scale, without applying the
+// FFT-based slot decoding that Encoder.Decode performs.
+//
+// This is intended for debugging ring-level issues: comparing the values returned by Decode against
+// an expected plaintext conflates an encoding-side bug with a noise-level one, whereas DecryptToCoeffs
+// exposes the raw decrypted polynomial so the two can be told apart.
+func DecryptToCoeffs(params Parameters, decryptor *rlwe.Decryptor, ct *rlwe.Ciphertext) (coeffs []float64) {
+
+	pt := decryptor.DecryptNew(ct)
+
+	ringQ := params.RingQ().AtLevel(pt.Level())
+
+	if pt.IsNTT {
+		ringQ.INTT(pt.Value, pt.Value)
+	}
+
+	N := params.N()
+
+	coeffsBigint := make([]*big.Int, N)
+	for i := range coeffsBigint {
+		coeffsBigint[i] = new(big.Int)
+	}
+	ringQ.PolyToBigintCentered(pt.Value, 1, coeffsBigint)
+
+	scale := pt.Scale.Float64()
+
+	coeffs = make([]float64, N)
+	for i, c := range coeffsBigint {
+		f, _ := new(big.Float).SetInt(c).Float64()
+		coeffs[i] = f / scale
+	}
+
+	return
+}
+
+// Rerandomize adds a fresh encryption of zero, sampled under enc at ct's level and with ct's
+// MetaData, directly onto ct. The value ct decrypts to is unchanged, but its noise is refreshed with
+// independent randomness, so that handing ct to a semi-honest party afterwards does not leak
+// information about ct's computation history through its low-order noise bits.
+//
+// Rerandomize only meaningfully hides computation history when enc holds a public key: an encryption
+// of zero generated under a secret key requires that same secret key to decrypt ct in the first
+// place, so it hides nothing from a party that can already decrypt.
+func Rerandomize(params Parameters, enc *rlwe.Encryptor, ct *rlwe.Ciphertext) (err error) {
+
+	zero := rlwe.NewCiphertext(params, ct.Degree(), ct.Level())
+	zero.MetaData = ct.MetaData
+
+	if err = enc.EncryptZero(zero); err != nil {
+		return fmt.Errorf("cannot Rerandomize: %w", err)
+	}
+
+	ringQ := params.RingQ().AtLevel(ct.Level())
+	for i := range ct.Value {
+		ringQ.Add(ct.Value[i], zero.Value[i], ct.Value[i])
+	}
+
+	return
+}
+
 // NewKeyGenerator instantiates a new rlwe.KeyGenerator.
 //
 // inputs:
@@ -72,3 +136,45 @@ func NewDecryptor(params Parameters, key *rlwe.SecretKey) *rlwe.Decryptor {
 func NewKeyGenerator(params Parameters) *rlwe.KeyGenerator {
 	return rlwe.NewKeyGenerator(params)
 }
+
+// Compatible reports whether ct0 and ct1 can be combined by Evaluator.Add, Evaluator.Sub or
+// Evaluator.Mul, and if not, a short human-readable reason why. It performs the same checks that
+// those methods themselves run internally, before any error from a deeply nested call surfaces, so
+// that a circuit can be sanity-checked before it is actually evaluated. It does not read or mutate
+// either ciphertext beyond its MetaData.
+//
+// The checks, in the order they are reported, are: neither ciphertext may be nil, they must come
+// from rings of the same degree, they must be in the same NTT/non-NTT domain, their combined degree
+// must not exceed the maximum degree of 2 supported after relinearization, both must still have at
+// least one remaining level, and they must share the same scale. The last check only matters for Add
+// and Sub, which require a common scale to combine operands meaningfully (see Evaluator.CheckScales);
+// Mul has no such requirement, so a scale mismatch reported here can safely be ignored when the
+// intended operation is Mul.
+func Compatible(ct0, ct1 *rlwe.Ciphertext) (ok bool, reason string) {
+
+	if ct0 == nil || ct1 == nil {
+		return false, "ct0 and ct1 cannot be nil"
+	}
+
+	if n0, n1 := ct0.Value[0].N(), ct1.Value[0].N(); n0 != n1 {
+		return false, fmt.Sprintf("ring degree mismatch: ct0 has N=%d, ct1 has N=%d", n0, n1)
+	}
+
+	if ct0.IsNTT != ct1.IsNTT {
+		return false, fmt.Sprintf("NTT domain mismatch: ct0.IsNTT=%t, ct1.IsNTT=%t", ct0.IsNTT, ct1.IsNTT)
+	}
+
+	if totDegree := ct0.Degree() + ct1.Degree(); totDegree > 2 {
+		return false, fmt.Sprintf("combined degree %d exceeds the maximum supported ciphertext degree of 2", totDegree)
+	}
+
+	if ct0.Level() < 0 || ct1.Level() < 0 {
+		return false, "ct0 or ct1 has no remaining level"
+	}
+
+	if ct0.Scale.Cmp(ct1.Scale) != 0 {
+		return false, fmt.Sprintf("scale mismatch: ct0.Scale=%f, ct1.Scale=%f", ct0.Scale.Float64(), ct1.Scale.Float64())
+	}
+
+	return true, ""
+}
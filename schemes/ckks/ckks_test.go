@@ -7,6 +7,7 @@ import (
 	"math"
 	"math/big"
 	"runtime"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -83,12 +84,32 @@ func TestCKKS(t *testing.T) {
 
 			for _, testSet := range []func(tc *testContext, t *testing.T){
 				testParameters,
+				testCompatible,
 				testEncoder,
+				testPrecision,
+				testGetPrecisionStats,
+				testRerandomize,
+				testDecryptToCoeffs,
 				testEvaluatorAdd,
 				testEvaluatorSub,
+				testEvaluatorNeg,
+				testEvaluatorMultByConstThenAddConst,
+				testEvaluatorMultByIntegerVector,
+				testEvaluatorZeroSlots,
+				testEvaluatorMultByi,
+			testEvaluatorModRaise,
+			testEvaluatorBroadcast,
+				testEvaluatorRotateColumnsComposed,
+				testEvaluatorAutomorphism,
 				testEvaluatorRescale,
+				testEvaluatorRemainingMultiplications,
+				testEvaluatorCheckScales,
+				testEvaluatorPool,
+				testEvaluatorShallowCopy,
 				testEvaluatorMul,
+				testEvaluatorMulRelinScaleChain,
 				testEvaluatorMulThenAdd,
+				testEvaluatorPowerSeries,
 				testBridge,
 			} {
 				testSet(tc, t)
@@ -270,6 +291,65 @@ func testParameters(tc *testContext, t *testing.T) {
 	})
 }
 
+func testCompatible(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Compatible"), func(t *testing.T) {
+
+		_, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+		_, _, ct1 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		ok, reason := Compatible(ct0, ct1)
+		require.True(t, ok)
+		require.Equal(t, "", reason)
+
+		t.Run("Nil", func(t *testing.T) {
+			ok, reason := Compatible(nil, ct1)
+			require.False(t, ok)
+			require.NotEqual(t, "", reason)
+		})
+
+		t.Run("RingDegree", func(t *testing.T) {
+			smaller := NewCiphertext(tc.params, ct0.Degree(), ct0.Level())
+			for i := range smaller.Value {
+				smaller.Value[i] = ring.NewPoly(ct0.Value[i].N()>>1, ct0.Level())
+			}
+
+			ok, reason := Compatible(ct0, smaller)
+			require.False(t, ok)
+			require.Contains(t, reason, "ring degree mismatch")
+		})
+
+		t.Run("NTTDomain", func(t *testing.T) {
+			_, _, other := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+			other.IsNTT = !ct0.IsNTT
+
+			ok, reason := Compatible(ct0, other)
+			require.False(t, ok)
+			require.Contains(t, reason, "NTT domain mismatch")
+		})
+
+		t.Run("Degree", func(t *testing.T) {
+			if ct0.Level() < 0 {
+				t.Skip("no remaining level to build a degree-2 ciphertext")
+			}
+			degree2 := NewCiphertext(tc.params, 2, ct0.Level())
+
+			ok, reason := Compatible(ct0, degree2)
+			require.False(t, ok)
+			require.Contains(t, reason, "combined degree")
+		})
+
+		t.Run("Scale", func(t *testing.T) {
+			_, _, other := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+			other.Scale = other.Scale.Mul(rlwe.NewScale(2))
+
+			ok, reason := Compatible(ct0, other)
+			require.False(t, ok)
+			require.Contains(t, reason, "scale mismatch")
+		})
+	})
+}
+
 func testEncoder(tc *testContext, t *testing.T) {
 
 	t.Run(GetTestName(tc.params, "Encoder/IsBatched=true"), func(t *testing.T) {
@@ -279,6 +359,114 @@ func testEncoder(tc *testContext, t *testing.T) {
 		VerifyTestVectors(tc.params, tc.encoder, nil, values, plaintext, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
 
+	t.Run(GetTestName(tc.params, "Encoder/EncodeScalarNew"), func(t *testing.T) {
+
+		value := complex(0.3, -0.7)
+		if tc.params.RingType() == ring.ConjugateInvariant {
+			value = complex(real(value), 0)
+		}
+
+		pt := tc.encoder.EncodeScalarNew(value, tc.params.MaxLevel(), tc.params.DefaultScale())
+
+		have := make([]complex128, tc.params.MaxSlots())
+		require.NoError(t, tc.encoder.Decode(pt, have))
+
+		for i, v := range have {
+			require.InDelta(t, real(value), real(v), 1e-2, "slot %d", i)
+			require.InDelta(t, imag(value), imag(v), 1e-2, "slot %d", i)
+		}
+	})
+
+	t.Run(GetTestName(tc.params, "Encoder/Encode/LengthMismatch"), func(t *testing.T) {
+
+		slots := tc.params.MaxSlots()
+
+		// Batched (slot-domain) encoding: fewer values than slots must be zero-padded, and more
+		// values than slots must return an error instead of silently truncating or panicking.
+		pt := NewPlaintext(tc.params, tc.params.MaxLevel())
+
+		values := make([]float64, slots/2)
+		for i := range values {
+			values[i] = 0.5
+		}
+
+		require.NoError(t, tc.encoder.Encode(values, pt))
+
+		have := make([]float64, slots)
+		require.NoError(t, tc.encoder.Decode(pt, have))
+
+		for i := slots / 2; i < slots; i++ {
+			require.InDelta(t, 0, have[i], 1e-2, "slot %d should have been zero-padded", i)
+		}
+
+		require.Error(t, tc.encoder.Encode(make([]float64, slots+1), pt))
+
+		if tc.params.RingType() != ring.ConjugateInvariant {
+			// Coefficient-domain encoding: same zero-pad/error-on-overflow contract.
+			ptCoeffs := NewPlaintext(tc.params, tc.params.MaxLevel())
+			ptCoeffs.IsBatched = false
+
+			coeffValues := make([]float64, tc.params.N()/2)
+			for i := range coeffValues {
+				coeffValues[i] = 0.5
+			}
+
+			require.NoError(t, tc.encoder.Encode(coeffValues, ptCoeffs))
+
+			ringQ := tc.params.RingQ().AtLevel(ptCoeffs.Level())
+			coeffsNTT := ptCoeffs.Value.CopyNew()
+			ringQ.INTT(*coeffsNTT, *coeffsNTT)
+
+			coeffsBigint := make([]*big.Int, tc.params.N())
+			for i := range coeffsBigint {
+				coeffsBigint[i] = new(big.Int)
+			}
+			ringQ.PolyToBigintCentered(*coeffsNTT, 1, coeffsBigint)
+
+			for i := len(coeffValues); i < tc.params.N(); i++ {
+				require.Zero(t, coeffsBigint[i].Sign(), "coefficient %d should have been zero-padded", i)
+			}
+
+			require.Error(t, tc.encoder.Encode(make([]float64, tc.params.N()+1), ptCoeffs))
+		}
+	})
+
+	t.Run(GetTestName(tc.params, "Encoder/ReEncodeNew"), func(t *testing.T) {
+
+		values, plaintext, _ := newTestVectors(tc, nil, -1-1i, 1+1i, t)
+
+		targetLevel := plaintext.Level() - 1
+		targetScale := rlwe.NewScale(plaintext.Scale.Float64() / 2)
+
+		plaintextOut, err := tc.encoder.ReEncodeNew(plaintext, targetLevel, targetScale)
+		require.NoError(t, err)
+		require.Equal(t, targetLevel, plaintextOut.Level())
+		require.Equal(t, targetScale, plaintextOut.Scale)
+
+		VerifyTestVectors(tc.params, tc.encoder, nil, values, plaintextOut, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+
+	t.Run(GetTestName(tc.params, "Encoder/DecodeVector"), func(t *testing.T) {
+
+		values, plaintext, _ := newTestVectors(tc, nil, -1-1i, 1+1i, t)
+
+		decoded, err := tc.encoder.DecodeVector(plaintext)
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(values)), decoded.Len())
+
+		for i := range values {
+			v, err := decoded.At(uint64(i))
+			require.NoError(t, err)
+			want, _ := values[i][0].Float64()
+			wantImag, _ := values[i][1].Float64()
+			require.InDelta(t, want, real(v), 0.01)
+			require.InDelta(t, wantImag, imag(v), 0.01)
+		}
+
+		_, err = decoded.At(decoded.Len())
+		require.Error(t, err, "out-of-range access should return an error rather than panicking")
+	})
+
 	logprec := float64(tc.params.LogDefaultScale()) / 2
 
 	t.Run(GetTestName(tc.params, "Encoder/IsBatched=true/DecodePublic/[]float64"), func(t *testing.T) {
@@ -421,6 +609,205 @@ func testEncoder(tc *testContext, t *testing.T) {
 
 		require.GreaterOrEqual(t, math.Log2(1/meanprec), minPrec)
 	})
+
+	t.Run(GetTestName(tc.params, "Encoder/EncodeNew"), func(t *testing.T) {
+
+		values, _, _ := newTestVectors(tc, nil, -1-1i, 1+1i, t)
+
+		level := tc.params.LevelsConsumedPerRescaling() - 1
+		scale := rlwe.NewScale(tc.params.Q()[level])
+
+		pt, err := tc.encoder.EncodeNew(values, level, scale)
+		require.NoError(t, err)
+		require.Equal(t, level, pt.Level())
+		require.True(t, pt.Scale.Equal(scale))
+
+		VerifyTestVectors(tc.params, tc.encoder, nil, values, pt, int(math.Round(math.Log2(scale.Float64())))-int(tc.params.LogN()), 0, *printPrecisionStats, t)
+	})
+
+	t.Run(GetTestName(tc.params, "Encoder/DecodeAndEstimateError"), func(t *testing.T) {
+
+		values, plaintext, _ := newTestVectors(tc, nil, -1-1i, 1+1i, t)
+
+		have := make([]complex128, len(values))
+
+		errorBound, err := tc.encoder.DecodeAndEstimateError(plaintext, have)
+		require.NoError(t, err)
+		require.Greater(t, errorBound, 0.0)
+
+		for i := range values {
+			want, _ := values[i][0].Float64()
+			wantImag, _ := values[i][1].Float64()
+			require.InDelta(t, want, real(have[i]), errorBound+0.01)
+			require.InDelta(t, wantImag, imag(have[i]), errorBound+0.01)
+		}
+	})
+}
+
+func testPrecision(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Precision"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		have := make([]complex128, len(values))
+		for i := range values {
+			have[i] = values[i].Complex128()
+		}
+
+		prec, err := Precision(tc.params, tc.encoder, tc.decryptor, ciphertext, have)
+		require.NoError(t, err)
+		require.Greater(t, prec, 0.0)
+
+		_, err = Precision(tc.params, tc.encoder, tc.decryptor, nil, have)
+		require.Error(t, err)
+	})
+}
+
+func testRerandomize(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Rerandomize"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorPk, -1-1i, 1+1i, t)
+
+		c1Before := ciphertext.Value[1].CopyNew()
+
+		require.NoError(t, Rerandomize(tc.params, tc.encryptorPk, ciphertext))
+
+		require.False(t, tc.params.RingQ().AtLevel(ciphertext.Level()).Equal(*c1Before, ciphertext.Value[1]))
+
+		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, values, ciphertext, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+}
+
+func testDecryptToCoeffs(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "DecryptToCoeffs"), func(t *testing.T) {
+
+		_, pt, ct := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		ringQ := tc.params.RingQ().AtLevel(pt.Level())
+
+		ptCoeffs := pt.Value.CopyNew()
+		if pt.IsNTT {
+			ringQ.INTT(*ptCoeffs, *ptCoeffs)
+		}
+
+		want := make([]*big.Int, tc.params.N())
+		for i := range want {
+			want[i] = new(big.Int)
+		}
+		ringQ.PolyToBigintCentered(*ptCoeffs, 1, want)
+
+		have := DecryptToCoeffs(tc.params, tc.decryptor, ct)
+		require.Equal(t, len(want), len(have))
+
+		scale := pt.Scale.Float64()
+
+		// DecryptToCoeffs decrypts and centers the raw plaintext polynomial, so it should match the
+		// plaintext that was encrypted, up to the fresh encryption noise, without going through the
+		// FFT-based slot decoding that Decode performs.
+		for i := range want {
+			wf, _ := new(big.Float).SetInt(want[i]).Float64()
+			require.InDelta(t, wf/scale, have[i], 1e-2)
+		}
+	})
+}
+
+func testEvaluatorRotateColumnsComposed(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Evaluator/RotateColumnsComposed"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		var gks []*rlwe.GaloisKey
+		for _, step := range []int{1, 2, 4} {
+			gks = append(gks, tc.kgen.GenGaloisKeyNew(tc.params.GaloisElement(step), tc.sk))
+		}
+		eval := tc.evaluator.WithKey(rlwe.NewMemEvaluationKeySet(nil, gks...))
+
+		k := 5 // 5 = 4 + 1, exercises two composed power-of-two rotations
+
+		want := make([]*bignum.Complex, len(values))
+		slots := len(values)
+		for i := range want {
+			want[i] = values[(i+k)%slots]
+		}
+
+		ciphertextOut, err := eval.RotateColumnsComposedNew(ciphertext, k)
+		require.NoError(t, err)
+
+		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, want, ciphertextOut, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+
+		// Missing the power-of-two key for step 8 must surface as an error rather than silently
+		// producing a wrong result.
+		_, err = eval.RotateColumnsComposedNew(ciphertext, 9)
+		require.Error(t, err)
+	})
+}
+
+func testEvaluatorAutomorphism(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Evaluator/Automorphism/GaloisElementForRotation"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		const k = 3
+
+		galEl := tc.params.GaloisElement(k)
+
+		eval := tc.evaluator.WithKey(rlwe.NewMemEvaluationKeySet(nil, tc.kgen.GenGaloisKeyNew(galEl, tc.sk)))
+
+		// Applying the automorphism for Rotate's own Galois element by hand must agree with Rotate,
+		// since Rotate is itself defined as Automorphism(GaloisElement(k)).
+		ciphertextOut := NewCiphertext(tc.params, ciphertext.Degree(), ciphertext.Level())
+		require.NoError(t, eval.Automorphism(ciphertext, galEl, ciphertextOut))
+
+		want := make([]*bignum.Complex, len(values))
+		slots := len(values)
+		for i := range want {
+			want[i] = values[(i+k)%slots]
+		}
+
+		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, want, ciphertextOut, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+
+		// GaloisElement is involutive under ModInvGaloisElement: applying the automorphism for the
+		// inverse element must undo the rotation above.
+		galElInv := tc.params.ModInvGaloisElement(galEl)
+		require.Equal(t, tc.params.GaloisElement(-k), galElInv)
+
+		eval = tc.evaluator.WithKey(rlwe.NewMemEvaluationKeySet(nil, tc.kgen.GenGaloisKeyNew(galElInv, tc.sk)))
+
+		ciphertextBack := NewCiphertext(tc.params, ciphertextOut.Degree(), ciphertextOut.Level())
+		require.NoError(t, eval.Automorphism(ciphertextOut, galElInv, ciphertextBack))
+
+		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, values, ciphertextBack, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+}
+
+func testGetPrecisionStats(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "GetPrecisionStats"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		have := make([]complex128, len(values))
+		for i := range values {
+			have[i] = values[i].Complex128()
+		}
+
+		precStats := GetPrecisionStats(tc.params, tc.encoder, tc.decryptor, have, ciphertext, 0, false)
+
+		meanL2, _ := precStats.MeanPrecision.L2.Float64()
+		minL2, _ := precStats.MinPrecision.L2.Float64()
+		maxL2, _ := precStats.MaxPrecision.L2.Float64()
+		medianL2, _ := precStats.MedianPrecision.L2.Float64()
+
+		require.Greater(t, meanL2, 0.0)
+		require.Greater(t, minL2, 0.0)
+		require.Greater(t, maxL2, 0.0)
+		require.Greater(t, medianL2, 0.0)
+	})
 }
 
 func testEvaluatorAdd(tc *testContext, t *testing.T) {
@@ -575,6 +962,284 @@ func testEvaluatorSub(tc *testContext, t *testing.T) {
 	})
 }
 
+func testEvaluatorNeg(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Evaluator/NegNew"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		for i := range values {
+			values[i].Neg(values[i])
+		}
+
+		scaleWant := ciphertext.Scale
+		levelWant := ciphertext.Level()
+
+		ciphertextNeg, err := tc.evaluator.NegNew(ciphertext)
+		require.NoError(t, err)
+
+		require.Equal(t, scaleWant, ciphertextNeg.Scale)
+		require.Equal(t, levelWant, ciphertextNeg.Level())
+
+		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, values, ciphertextNeg, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+
+	t.Run(GetTestName(tc.params, "Evaluator/Neg"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		for i := range values {
+			values[i].Neg(values[i])
+		}
+
+		require.NoError(t, tc.evaluator.Neg(ciphertext, ciphertext))
+
+		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, values, ciphertext, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+
+	t.Run(GetTestName(tc.params, "Evaluator/Neg/RoundTrip"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		ciphertextNegNeg, err := tc.evaluator.NegNew(ciphertext)
+		require.NoError(t, err)
+
+		require.NoError(t, tc.evaluator.Neg(ciphertextNegNeg, ciphertextNegNeg))
+
+		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, values, ciphertextNegNeg, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+}
+
+func testEvaluatorMultByConstThenAddConst(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Evaluator/MultByConstThenAddConstNew"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		mult := randomConst(tc.params.RingType(), tc.encoder.Prec(), -1+1i, -1+1i)
+		add := randomConst(tc.params.RingType(), tc.encoder.Prec(), -1+1i, -1+1i)
+
+		mul := bignum.NewComplexMultiplier()
+
+		for i := range values {
+			mul.Mul(values[i], mult, values[i])
+			values[i].Add(values[i], add)
+		}
+
+		ciphertextOut, err := tc.evaluator.MultByConstThenAddConstNew(ciphertext, mult.Complex128(), add.Complex128())
+		require.NoError(t, err)
+
+		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, values, ciphertextOut, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+
+	t.Run(GetTestName(tc.params, "Evaluator/MultByConstThenAddConst"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		mult := randomConst(tc.params.RingType(), tc.encoder.Prec(), -1+1i, -1+1i)
+		add := randomConst(tc.params.RingType(), tc.encoder.Prec(), -1+1i, -1+1i)
+
+		mul := bignum.NewComplexMultiplier()
+
+		for i := range values {
+			mul.Mul(values[i], mult, values[i])
+			values[i].Add(values[i], add)
+		}
+
+		require.NoError(t, tc.evaluator.MultByConstThenAddConst(ciphertext, mult.Complex128(), add.Complex128(), ciphertext))
+
+		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, values, ciphertext, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+}
+
+func testEvaluatorMultByIntegerVector(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Evaluator/MultByIntegerVectorNew"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		masks := make([]int64, len(values))
+		for i := range masks {
+			masks[i] = int64(i%7) - 3
+			values[i][0].Mul(values[i][0], new(big.Float).SetInt64(masks[i]))
+			values[i][1].Mul(values[i][1], new(big.Float).SetInt64(masks[i]))
+		}
+
+		ciphertextOut, err := tc.evaluator.MultByIntegerVectorNew(ciphertext, masks)
+		require.NoError(t, err)
+
+		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, values, ciphertextOut, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+}
+
+func testEvaluatorZeroSlots(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Evaluator/ZeroSlotsNew"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		start, length := 2, 3
+
+		for i := start; i < start+length; i++ {
+			values[i][0].SetInt64(0)
+			values[i][1].SetInt64(0)
+		}
+
+		ciphertextOut, err := tc.evaluator.ZeroSlotsNew(ciphertext, start, length)
+		require.NoError(t, err)
+
+		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, values, ciphertextOut, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+}
+
+func testEvaluatorMultByi(tc *testContext, t *testing.T) {
+
+	if tc.params.RingType() == ring.ConjugateInvariant {
+		t.Skip("MultByi/DivByi are not supported when params.RingType() == ring.ConjugateInvariant")
+	}
+
+	mul := bignum.NewComplexMultiplier()
+	i := bignum.NewComplex().SetComplex128(1i)
+	negi := bignum.NewComplex().SetComplex128(-1i)
+
+	t.Run(GetTestName(tc.params, "Evaluator/MultByiNew"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		scaleWant := ciphertext.Scale
+		levelWant := ciphertext.Level()
+
+		for _, v := range values {
+			mul.Mul(v, i, v)
+		}
+
+		ciphertextOut, err := tc.evaluator.MultByiNew(ciphertext)
+		require.NoError(t, err)
+
+		require.Equal(t, scaleWant, ciphertextOut.Scale)
+		require.Equal(t, levelWant, ciphertextOut.Level())
+
+		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, values, ciphertextOut, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+
+	t.Run(GetTestName(tc.params, "Evaluator/DivByi"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		for _, v := range values {
+			mul.Mul(v, negi, v)
+		}
+
+		require.NoError(t, tc.evaluator.DivByi(ciphertext, ciphertext))
+
+		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, values, ciphertext, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+
+	t.Run(GetTestName(tc.params, "Evaluator/MultByi/DivByi/RoundTrip"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		ciphertextOut, err := tc.evaluator.MultByiNew(ciphertext)
+		require.NoError(t, err)
+
+		require.NoError(t, tc.evaluator.DivByi(ciphertextOut, ciphertextOut))
+
+		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, values, ciphertextOut, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+}
+
+func testEvaluatorModRaise(tc *testContext, t *testing.T) {
+
+	if tc.params.MaxLevel() < 1 {
+		t.Skip("not enough levels to test ModRaise")
+	}
+
+	t.Run(GetTestName(tc.params, "Evaluator/ModRaiseNew"), func(t *testing.T) {
+
+		_, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		tc.evaluator.DropLevel(ciphertext, 1)
+		level := ciphertext.Level()
+		require.Equal(t, tc.params.MaxLevel()-1, level)
+
+		ciphertextOut, err := tc.evaluator.ModRaiseNew(ciphertext)
+		require.NoError(t, err)
+
+		require.Equal(t, tc.params.MaxLevel(), ciphertextOut.Level())
+		require.Equal(t, ciphertext.Scale, ciphertextOut.Scale)
+
+		// ModRaise only guarantees that opOut's residues agree with ctIn's on every modulus ctIn
+		// already had: dropping it back to ctIn's original level must recover ctIn exactly. It does
+		// not, by itself, preserve the values ciphertextOut decrypts to (see ModRaise's doc comment).
+		tc.evaluator.DropLevel(ciphertextOut, tc.params.MaxLevel()-level)
+		require.True(t, ciphertext.Equal(ciphertextOut))
+	})
+}
+
+func testEvaluatorBroadcast(tc *testContext, t *testing.T) {
+
+	const rows = 4
+	const cols = 4
+
+	if rows*cols > tc.params.MaxSlots() {
+		t.Skip("not enough slots to test BroadcastRow/BroadcastColumn")
+	}
+
+	galEls := append(rlwe.GaloisElementsForReplicate(tc.params, 1, cols), rlwe.GaloisElementsForReplicate(tc.params, cols, rows)...)
+	evk := rlwe.NewMemEvaluationKeySet(nil, tc.kgen.GenGaloisKeysNew(galEls, tc.sk)...)
+	eval := tc.evaluator.WithKey(evk)
+
+	t.Run(GetTestName(tc.params, "Evaluator/BroadcastColumn"), func(t *testing.T) {
+
+		values := make([]complex128, tc.params.MaxSlots())
+		for row := 0; row < rows; row++ {
+			values[row*cols] = complex(float64(row+1), 0)
+		}
+
+		pt := NewPlaintext(tc.params, tc.params.MaxLevel())
+		require.NoError(t, tc.encoder.Encode(values, pt))
+		ct, err := tc.encryptorSk.EncryptNew(pt)
+		require.NoError(t, err)
+
+		ctOut, err := eval.BroadcastColumnNew(ct, rows, cols)
+		require.NoError(t, err)
+
+		want := make([]complex128, tc.params.MaxSlots())
+		for row := 0; row < rows; row++ {
+			for col := 0; col < cols; col++ {
+				want[row*cols+col] = complex(float64(row+1), 0)
+			}
+		}
+
+		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, want, ctOut, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+
+	t.Run(GetTestName(tc.params, "Evaluator/BroadcastRow"), func(t *testing.T) {
+
+		values := make([]complex128, tc.params.MaxSlots())
+		for col := 0; col < cols; col++ {
+			values[col] = complex(float64(col+1), 0)
+		}
+
+		pt := NewPlaintext(tc.params, tc.params.MaxLevel())
+		require.NoError(t, tc.encoder.Encode(values, pt))
+		ct, err := tc.encryptorSk.EncryptNew(pt)
+		require.NoError(t, err)
+
+		ctOut, err := eval.BroadcastRowNew(ct, rows, cols)
+		require.NoError(t, err)
+
+		want := make([]complex128, tc.params.MaxSlots())
+		for row := 0; row < rows; row++ {
+			for col := 0; col < cols; col++ {
+				want[row*cols+col] = complex(float64(col+1), 0)
+			}
+		}
+
+		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, want, ctOut, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+}
+
 func testEvaluatorRescale(tc *testContext, t *testing.T) {
 
 	t.Run(GetTestName(tc.params, "Evaluator/RescaleTo/Single"), func(t *testing.T) {
@@ -623,6 +1288,171 @@ func testEvaluatorRescale(tc *testContext, t *testing.T) {
 
 		VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, values, ciphertext, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
+
+	t.Run(GetTestName(tc.params, "Evaluator/RescaleBatch"), func(t *testing.T) {
+
+		if tc.params.MaxLevel() < 2 {
+			t.Skip("skipping test for params max level < 2")
+		}
+
+		batch := 4
+
+		valuesBatch := make([][]*bignum.Complex, batch)
+		ciphertexts := make([]*rlwe.Ciphertext, batch)
+
+		for i := range ciphertexts {
+			valuesBatch[i], _, ciphertexts[i] = newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+			constant := tc.ringQ.SubRings[ciphertexts[i].Level()].Modulus
+			require.NoError(t, tc.evaluator.Mul(ciphertexts[i], constant, ciphertexts[i]))
+			ciphertexts[i].Scale = ciphertexts[i].Scale.Mul(rlwe.NewScale(constant))
+		}
+
+		require.NoError(t, tc.evaluator.RescaleBatch(ciphertexts, tc.params.DefaultScale().Float64()))
+
+		level := ciphertexts[0].Level()
+		for i, ciphertext := range ciphertexts {
+			require.Equal(t, level, ciphertext.Level())
+			VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, valuesBatch[i], ciphertext, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		}
+	})
+}
+
+func testEvaluatorRemainingMultiplications(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Evaluator/RemainingMultiplications"), func(t *testing.T) {
+
+		_, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		want := tc.evaluator.RemainingMultiplications(ciphertext)
+
+		var got uint64
+		for {
+			if err := tc.evaluator.MulRelin(ciphertext, ciphertext, ciphertext); err != nil {
+				break
+			}
+
+			if err := tc.evaluator.Rescale(ciphertext, ciphertext); err != nil {
+				break
+			}
+
+			got++
+		}
+
+		require.Equal(t, want, got)
+	})
+}
+
+func testEvaluatorCheckScales(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Evaluator/CheckScales"), func(t *testing.T) {
+
+		_, _, ciphertext1 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+		_, _, ciphertext2 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		require.NoError(t, tc.evaluator.CheckScales(ciphertext1, ciphertext2))
+
+		// Changes the scale of ciphertext2 without rescaling, to simulate scale drift.
+		ciphertext2.Scale = ciphertext2.Scale.Mul(rlwe.NewScale(2))
+
+		require.Error(t, tc.evaluator.CheckScales(ciphertext1, ciphertext2))
+	})
+
+	t.Run(GetTestName(tc.params, "Evaluator/WithScaleChecks"), func(t *testing.T) {
+
+		_, _, ciphertext1 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+		_, _, ciphertext2 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		ciphertext2.Scale = ciphertext2.Scale.Mul(rlwe.NewScale(2))
+
+		// By default, i.e. without WithScaleChecks, a scale mismatch does not surface as an error.
+		require.NoError(t, tc.evaluator.Add(ciphertext1, ciphertext2, NewCiphertext(tc.params, ciphertext1.Degree(), ciphertext1.Level())))
+
+		evalDebug := tc.evaluator.WithScaleChecks(true)
+
+		_, err := evalDebug.AddNew(ciphertext1, ciphertext2)
+		require.Error(t, err)
+
+		_, err = evalDebug.SubNew(ciphertext1, ciphertext2)
+		require.Error(t, err)
+
+		// Once the scales match again, the checks no longer get in the way.
+		ciphertext2.Scale = ciphertext1.Scale
+
+		_, err = evalDebug.AddNew(ciphertext1, ciphertext2)
+		require.NoError(t, err)
+	})
+}
+
+func testEvaluatorPool(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Evaluator/Pool"), func(t *testing.T) {
+
+		const numWorkers = 4
+		const numTasks = 16
+
+		pool := NewEvaluatorParallel(tc.params, tc.evaluator.EvaluationKeySet, numWorkers)
+
+		valuesWant := make([][]*bignum.Complex, numTasks)
+		ciphertexts := make([]*rlwe.Ciphertext, numTasks)
+		for i := range ciphertexts {
+			valuesWant[i], _, ciphertexts[i] = newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+			for j := range valuesWant[i] {
+				valuesWant[i][j].Add(valuesWant[i][j], valuesWant[i][j])
+			}
+		}
+
+		var wg sync.WaitGroup
+		for i := range ciphertexts {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				pool.Batch(func(eval *Evaluator) {
+					require.NoError(t, eval.Add(ciphertexts[i], ciphertexts[i], ciphertexts[i]))
+				})
+			}(i)
+		}
+		wg.Wait()
+
+		for i := range ciphertexts {
+			VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, valuesWant[i], ciphertexts[i], tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		}
+	})
+}
+
+func testEvaluatorShallowCopy(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Evaluator/ShallowCopy"), func(t *testing.T) {
+
+		const numWorkers = 4
+
+		valuesWant := make([][]*bignum.Complex, numWorkers)
+		ciphertexts := make([]*rlwe.Ciphertext, numWorkers)
+		for i := range ciphertexts {
+			valuesWant[i], _, ciphertexts[i] = newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+			for j := range valuesWant[i] {
+				valuesWant[i][j].Add(valuesWant[i][j], valuesWant[i][j])
+			}
+		}
+
+		var wg sync.WaitGroup
+		for i := range ciphertexts {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				// Each goroutine gets its own shallow copy, sharing keys/parameters/NTT tables with
+				// tc.evaluator but with private temporary buffers, so this races on nothing even
+				// though all copies derive from the same base Evaluator.
+				eval := tc.evaluator.ShallowCopy()
+				require.NoError(t, eval.Add(ciphertexts[i], ciphertexts[i], ciphertexts[i]))
+			}(i)
+		}
+		wg.Wait()
+
+		for i := range ciphertexts {
+			VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, valuesWant[i], ciphertexts[i], tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		}
+	})
 }
 
 func testEvaluatorMul(tc *testContext, t *testing.T) {
@@ -753,6 +1583,49 @@ func testEvaluatorMul(tc *testContext, t *testing.T) {
 	})
 }
 
+// testEvaluatorMulRelinScaleChain checks that MulRelin always sets the output scale to the exact
+// product of the input scales, for both the Ciphertext x Ciphertext and Ciphertext x Plaintext cases,
+// and that this tracking survives a chain of Mul/Rescale operations, matching the analytically
+// expected scale at every step.
+func testEvaluatorMulRelinScaleChain(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Evaluator/MulRelin/ScaleChain"), func(t *testing.T) {
+
+		if tc.params.MaxLevel() < 4 {
+			t.Skip("skipping test for params max level < 4")
+		}
+
+		_, plaintext, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		nbRescales := tc.params.LevelsConsumedPerRescaling()
+
+		rescaledScale := func(scale rlwe.Scale, level int) rlwe.Scale {
+			for i := 0; i < nbRescales; i++ {
+				scale = scale.Div(rlwe.NewScale(tc.ringQ.SubRings[level-i].Modulus))
+			}
+			return scale
+		}
+
+		// Ciphertext x Ciphertext: the output scale must be exactly the product of the input scales.
+		wantScale := ciphertext.Scale.Mul(ciphertext.Scale)
+		require.NoError(t, tc.evaluator.MulRelin(ciphertext, ciphertext, ciphertext))
+		require.True(t, ciphertext.Scale.Equal(wantScale), "MulRelin(Ct, Ct) must set opOut.Scale to op0.Scale * op1.Scale")
+
+		wantScale = rescaledScale(wantScale, ciphertext.Level())
+		require.NoError(t, tc.evaluator.Rescale(ciphertext, ciphertext))
+		require.True(t, ciphertext.Scale.Equal(wantScale), "Rescale must divide the scale by the moduli it drops")
+
+		// Ciphertext x Plaintext: MulRelin must track the scale the same way as for Ct x Ct.
+		wantScale = ciphertext.Scale.Mul(plaintext.Scale)
+		require.NoError(t, tc.evaluator.MulRelin(ciphertext, plaintext, ciphertext))
+		require.True(t, ciphertext.Scale.Equal(wantScale), "MulRelin(Ct, Pt) must set opOut.Scale to op0.Scale * op1.Scale")
+
+		wantScale = rescaledScale(wantScale, ciphertext.Level())
+		require.NoError(t, tc.evaluator.Rescale(ciphertext, ciphertext))
+		require.True(t, ciphertext.Scale.Equal(wantScale), "Rescale must divide the scale by the moduli it drops")
+	})
+}
+
 func testEvaluatorMulThenAdd(tc *testContext, t *testing.T) {
 
 	t.Run(GetTestName(tc.params, "Evaluator/MulThenAdd/Scalar"), func(t *testing.T) {
@@ -868,6 +1741,46 @@ func testEvaluatorMulThenAdd(tc *testContext, t *testing.T) {
 	})
 }
 
+func testEvaluatorPowerSeries(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Evaluator/PowerSeries"), func(t *testing.T) {
+
+		if tc.params.MaxLevel() < 3 {
+			t.Skip("skipping test for params max level < 3")
+		}
+
+		const n = 4
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1, 1, t)
+
+		powers, err := tc.evaluator.PowerSeries(ciphertext, n)
+		require.NoError(t, err)
+		require.Len(t, powers, n)
+
+		minLevel := powers[0].Level()
+		for _, ct := range powers[1:] {
+			require.Equal(t, minLevel, ct.Level())
+		}
+
+		mul := bignum.NewComplexMultiplier()
+
+		want := make([]*bignum.Complex, len(values))
+		for i := range want {
+			want[i] = values[i].Clone()
+		}
+
+		for k, ct := range powers {
+			VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, want, ct, tc.params.LogDefaultScale()-10, 0, *printPrecisionStats, t)
+
+			if k+1 < n {
+				for i := range want {
+					mul.Mul(want[i], values[i], want[i])
+				}
+			}
+		}
+	})
+}
+
 func testBridge(tc *testContext, t *testing.T) {
 
 	t.Run(GetTestName(tc.params, "Bridge"), func(t *testing.T) {
@@ -133,6 +133,9 @@ func (ecd Encoder) GetRLWEParameters() rlwe.Parameters {
 // Encoding domain is done according to the metadata of the plaintext.
 // User must ensure that 1 <= len(values) <= 2^pt.LogMaxDimensions < 2^logN.
 // The imaginary part will be discarded if ringType == ring.ConjugateInvariant.
+// If len(values) is smaller than the number of available slots (or, for a non-batched plaintext,
+// than the ring degree N), the remaining positions are implicitly zero-padded. If len(values)
+// exceeds that capacity, Encode returns an error instead of silently truncating the input.
 func (ecd Encoder) Encode(values FloatSlice, pt *rlwe.Plaintext) (err error) {
 
 	if pt.IsBatched {
@@ -167,6 +170,69 @@ func (ecd Encoder) Encode(values FloatSlice, pt *rlwe.Plaintext) (err error) {
 	return
 }
 
+// EncodeNew allocates a new plaintext at the specified level and scale and encodes values on it.
+// It is a convenience wrapper around NewPlaintext followed by Encode, sparing the caller from
+// having to allocate the plaintext at MaxLevel and then drop it to the target level.
+func (ecd Encoder) EncodeNew(values FloatSlice, level int, scale rlwe.Scale) (pt *rlwe.Plaintext, err error) {
+	pt = NewPlaintext(ecd.parameters, level)
+	pt.Scale = scale
+	return pt, ecd.Encode(values, pt)
+}
+
+// EncodeScalarNew allocates a new plaintext at the specified level and scale and encodes value
+// repeated on every slot, at MaxSlots.
+//
+// Under the canonical embedding used by Embed, the plaintext polynomial for a constant slot vector
+// is, by uniqueness of interpolation, real(value) + imag(value)*X^(N/2), since X^(N/2) evaluates to
+// i on every slot (see MultByi) - i.e. at most two nonzero coefficients, at index 0 and at index
+// pt.Slots() = N/2. EncodeScalarNew exploits this to write those coefficients directly, sparing the
+// caller the N-length slice and the FFT that Encode(values, pt) would run for an explicitly
+// materialized constant vector. With ring.ConjugateInvariant, only the real part is written.
+func (ecd Encoder) EncodeScalarNew(value complex128, level int, scale rlwe.Scale) (pt *rlwe.Plaintext) {
+
+	pt = NewPlaintext(ecd.parameters, level)
+	pt.Scale = scale
+
+	ringQ := ecd.parameters.RingQ().AtLevel(level)
+
+	SingleFloat64ToFixedPointCRT(ringQ, 0, real(value), scale.Float64(), pt.Value.Coeffs)
+
+	if ecd.parameters.RingType() == ring.Standard {
+		SingleFloat64ToFixedPointCRT(ringQ, pt.Slots(), imag(value), scale.Float64(), pt.Value.Coeffs)
+	}
+
+	rlwe.NTTSparseAndMontgomery(ringQ, pt.MetaData, pt.Value)
+
+	return pt
+}
+
+// ReEncodeNew decodes pt and re-encodes the decoded values at the given level and scale, returning
+// the result in a newly allocated plaintext with the same batching and dimensions as pt.
+//
+// This is a convenience wrapper around Decode followed by EncodeNew, meant for iterative refinement
+// loops that decrypt, adjust values in the clear, and re-encrypt: it spares the caller from having to
+// track pt's slot count and dimensions by hand when rebuilding the plaintext at a different
+// level/scale. targetLevel may be lower or equal to pt.Level(); it is not validated against
+// ecd.parameters.MaxLevel() here, so a value outside [0, MaxLevel()] makes the allocation of the
+// output plaintext panic.
+func (ecd Encoder) ReEncodeNew(pt *rlwe.Plaintext, targetLevel int, targetScale rlwe.Scale) (ptOut *rlwe.Plaintext, err error) {
+
+	values := make([]complex128, pt.Slots())
+	if err = ecd.Decode(pt, values); err != nil {
+		return nil, fmt.Errorf("cannot ReEncodeNew: %w", err)
+	}
+
+	ptOut = NewPlaintext(ecd.parameters, targetLevel)
+	ptOut.LogDimensions = pt.LogDimensions
+	ptOut.Scale = targetScale
+
+	if err = ecd.Encode(values, ptOut); err != nil {
+		return nil, fmt.Errorf("cannot ReEncodeNew: %w", err)
+	}
+
+	return ptOut, nil
+}
+
 // Decode decodes the input plaintext on a new FloatSlice.
 func (ecd Encoder) Decode(pt *rlwe.Plaintext, values FloatSlice) (err error) {
 	return ecd.DecodePublic(pt, values, 0)
@@ -179,6 +245,56 @@ func (ecd Encoder) DecodePublic(pt *rlwe.Plaintext, values FloatSlice, logprec f
 	return ecd.decodePublic(pt, values, logprec)
 }
 
+// DecodeAndEstimateError decodes the input plaintext on values and additionally returns a cheap,
+// non-statistical upper bound on the magnitude of the encoding/decoding rounding error, derived
+// from the plaintext's scale and level. Every encoded coefficient is rounded to the nearest integer
+// before being reduced modulo the ciphertext modulus, inducing a rounding error of at most 0.5 in
+// absolute value pre-scaling, i.e. 0.5/scale once decoded back; each rescaling consumed down to
+// pt.Level() repeats this rounding once more, so the bound grows linearly with the number of moduli
+// consumed so far. It does not account for any homomorphic noise accumulated by prior operations on
+// the underlying ciphertext, so it is meant as a quick sanity check rather than a substitute for an
+// end-to-end noise estimate.
+func (ecd Encoder) DecodeAndEstimateError(pt *rlwe.Plaintext, values FloatSlice) (errorBound float64, err error) {
+
+	if err = ecd.Decode(pt, values); err != nil {
+		return 0, err
+	}
+
+	levelsConsumed := float64(ecd.parameters.MaxLevel() - pt.Level() + 1)
+
+	return 0.5 * levelsConsumed / pt.Scale.Float64(), nil
+}
+
+// DecodedVector wraps the []complex128 produced by DecodeVector, providing bounds-checked access to
+// its slots instead of letting callers index the underlying slice directly, which panics on an
+// out-of-range index rather than reporting it through the usual error-returning convention.
+type DecodedVector struct {
+	values []complex128
+}
+
+// Len returns the number of slots held by v.
+func (v DecodedVector) Len() uint64 {
+	return uint64(len(v.values))
+}
+
+// At returns the value of the i-th slot, or an error if i is out of range.
+func (v DecodedVector) At(i uint64) (complex128, error) {
+	if i >= v.Len() {
+		return 0, fmt.Errorf("cannot At: index %d out of range, vector has %d slots", i, v.Len())
+	}
+	return v.values[i], nil
+}
+
+// DecodeVector decodes the input plaintext's batched slots and returns them wrapped in a
+// DecodedVector, sized to pt's number of slots.
+func (ecd Encoder) DecodeVector(pt *rlwe.Plaintext) (v DecodedVector, err error) {
+	values := make([]complex128, pt.Slots())
+	if err = ecd.Decode(pt, values); err != nil {
+		return DecodedVector{}, err
+	}
+	return DecodedVector{values: values}, nil
+}
+
 // Embed is a generic method to encode a FloatSlice on the target polyOut.
 // This method it as the core of the slot encoding.
 // Values are encoded according to the provided metadata.
@@ -5,18 +5,26 @@ import (
 	"math/big"
 
 	"github.com/tuneinsight/lattigo/v5/core/rlwe"
+	"github.com/tuneinsight/lattigo/v5/he"
 	"github.com/tuneinsight/lattigo/v5/ring"
 	"github.com/tuneinsight/lattigo/v5/ring/ringqp"
 	"github.com/tuneinsight/lattigo/v5/utils"
 	"github.com/tuneinsight/lattigo/v5/utils/bignum"
 )
 
+// Ensures that Evaluator complies to the he.EvaluatorWithRotations interface.
+var _ he.EvaluatorWithRotations = Evaluator{}
+
 // Evaluator is a struct that holds the necessary elements to execute the homomorphic operations between Ciphertexts and/or Plaintexts.
 // It also holds a memory buffer used to store intermediate computations.
 type Evaluator struct {
 	*Encoder
 	*evaluatorBuffers
 	*rlwe.Evaluator
+
+	// debug, if true, makes Add and Sub call CheckScales on their ciphertext-ciphertext operands
+	// before evaluating, see WithScaleChecks.
+	debug bool
 }
 
 // NewEvaluator creates a new Evaluator, that can be used to do homomorphic
@@ -68,6 +76,14 @@ func (eval Evaluator) Add(op0 *rlwe.Ciphertext, op1 rlwe.Operand, opOut *rlwe.Ci
 	switch op1 := op1.(type) {
 	case rlwe.ElementInterface[ring.Poly]:
 
+		if eval.debug {
+			if ct1, ok := op1.(*rlwe.Ciphertext); ok {
+				if err = eval.CheckScales(op0, ct1); err != nil {
+					return fmt.Errorf("cannot Add: %w", err)
+				}
+			}
+		}
+
 		// Checks operand validity and retrieves minimum level
 		degree, level, err := eval.InitOutputBinaryOp(op0.El(), op1.El(), op0.Degree()+op1.Degree(), opOut.El())
 		if err != nil {
@@ -158,6 +174,14 @@ func (eval Evaluator) Sub(op0 *rlwe.Ciphertext, op1 rlwe.Operand, opOut *rlwe.Ci
 	switch op1 := op1.(type) {
 	case rlwe.ElementInterface[ring.Poly]:
 
+		if eval.debug {
+			if ct1, ok := op1.(*rlwe.Ciphertext); ok {
+				if err = eval.CheckScales(op0, ct1); err != nil {
+					return fmt.Errorf("cannot Sub: %w", err)
+				}
+			}
+		}
+
 		// Checks operand validity and retrieves minimum level
 		degree, level, err := eval.InitOutputBinaryOp(op0.El(), op1.El(), op0.Degree()+op1.Degree(), opOut.El())
 		if err != nil {
@@ -243,6 +267,331 @@ func (eval Evaluator) SubNew(op0 *rlwe.Ciphertext, op1 rlwe.Operand) (opOut *rlw
 	return opOut, eval.Sub(op0, op1, opOut)
 }
 
+// NegNew negates op0 and returns the result in a newly created element.
+func (eval Evaluator) NegNew(op0 *rlwe.Ciphertext) (opOut *rlwe.Ciphertext, err error) {
+	opOut = NewCiphertext(*eval.GetParameters(), op0.Degree(), op0.Level())
+	return opOut, eval.Neg(op0, opOut)
+}
+
+// Neg negates op0 and returns the result in opOut, preserving its scale and level exactly:
+// unlike Mul(op0, -1, opOut), it negates the ciphertext's coefficients modulo the current
+// moduli directly, without going through the scalar-multiplication path.
+func (eval Evaluator) Neg(op0, opOut *rlwe.Ciphertext) (err error) {
+
+	_, level, err := eval.InitOutputUnaryOp(op0.El(), opOut.El())
+	if err != nil {
+		return fmt.Errorf("cannot Neg: %w", err)
+	}
+
+	opOut.Resize(op0.Degree(), level)
+
+	ringQ := eval.GetParameters().RingQ().AtLevel(level)
+
+	for i := range op0.Value {
+		ringQ.Neg(op0.Value[i], opOut.Value[i])
+	}
+
+	*opOut.MetaData = *op0.MetaData
+
+	return
+}
+
+// MultByConstThenAddConstNew evaluates opOut = op0*mult + add, where mult and add are scalars
+// applied uniformly to every slot, and returns the result in a newly created element.
+func (eval Evaluator) MultByConstThenAddConstNew(op0 *rlwe.Ciphertext, mult, add complex128) (opOut *rlwe.Ciphertext, err error) {
+	opOut = NewCiphertext(*eval.GetParameters(), op0.Degree(), op0.Level())
+	return opOut, eval.MultByConstThenAddConst(op0, mult, add, opOut)
+}
+
+// MultByConstThenAddConst evaluates opOut = op0*mult + add, where mult and add are scalars
+// applied uniformly to every slot, and returns the result in opOut.
+//
+// This is equivalent to calling Mul(op0, mult, opOut) followed by Add(opOut, add, opOut), but
+// fuses the two calls so that add is automatically encoded and scaled to match opOut's scale
+// after the multiplication, sparing the caller from having to rescale, or pre-scale add, in
+// between the two steps. See Mul for how mult's scaling factor is chosen.
+func (eval Evaluator) MultByConstThenAddConst(op0 *rlwe.Ciphertext, mult, add complex128, opOut *rlwe.Ciphertext) (err error) {
+
+	if err = eval.Mul(op0, mult, opOut); err != nil {
+		return fmt.Errorf("cannot MultByConstThenAddConst: %w", err)
+	}
+
+	if err = eval.Add(opOut, add, opOut); err != nil {
+		return fmt.Errorf("cannot MultByConstThenAddConst: %w", err)
+	}
+
+	return
+}
+
+// MultByIntegerVectorNew multiplies ctIn by the per-slot integer masks and returns the result in a
+// newly created element, at the same scale as ctIn.
+func (eval Evaluator) MultByIntegerVectorNew(ctIn *rlwe.Ciphertext, masks []int64) (opOut *rlwe.Ciphertext, err error) {
+	opOut = NewCiphertext(*eval.GetParameters(), ctIn.Degree(), ctIn.Level())
+	return opOut, eval.MultByIntegerVector(ctIn, masks, opOut)
+}
+
+// MultByIntegerVector multiplies ctIn by the per-slot integer masks and returns the result in opOut,
+// at the same scale as ctIn.
+//
+// A mask that is the same Gaussian integer on every slot can be encoded at scale 1, so multiplying by
+// it (see Mul) never grows the scale and needs no Rescale - but a plaintext that carries a different
+// integer per slot is, in general, only integer-valued slot-wise: its underlying polynomial
+// coefficients (what the ciphertext is actually multiplied by in the ring) are not, so encoding it at
+// scale 1 would round away the information that distinguishes the masks and produce garbage. This
+// method instead encodes masks at the current level's modulus, exactly like Mul with a []float64
+// operand, and then rescales the product back down by that same modulus before returning, so that
+// from the caller's perspective opOut ends up at ctIn.Scale and one level lower, with no separate
+// Rescale call needed - at the cost of consuming one level internally, which a true scale-1 encoding
+// would not.
+//
+// Every output coefficient is the sum of up to N input coefficients each multiplied by a mask, so the
+// coefficients of the product can grow by at most a factor N*maxMask over those of ctIn, where
+// maxMask is the largest mask in absolute value; callers must ensure that this growth does not make
+// the true (non-modular) result exceed the modulus being multiplied by, or it will silently wrap
+// around that modulus before the rescale has a chance to bring it back down.
+//
+// len(masks) must be at most ctIn.Slots(); slots beyond len(masks) are zeroed.
+func (eval Evaluator) MultByIntegerVector(ctIn *rlwe.Ciphertext, masks []int64, opOut *rlwe.Ciphertext) (err error) {
+
+	if len(masks) > ctIn.Slots() {
+		return fmt.Errorf("cannot MultByIntegerVector: len(masks) must be at most %d, but is %d", ctIn.Slots(), len(masks))
+	}
+
+	values := make([]float64, len(masks))
+	for i, mask := range masks {
+		values[i] = float64(mask)
+	}
+
+	if err = eval.Mul(ctIn, values, opOut); err != nil {
+		return fmt.Errorf("cannot MultByIntegerVector: %w", err)
+	}
+
+	return eval.Rescale(opOut, opOut)
+}
+
+// ZeroSlotsNew sets ctIn's slots in [start, start+length) to (approximately) zero, leaving every
+// other slot unchanged, and returns the result in a newly created element. See ZeroSlots.
+func (eval Evaluator) ZeroSlotsNew(ctIn *rlwe.Ciphertext, start, length int) (opOut *rlwe.Ciphertext, err error) {
+	opOut = NewCiphertext(*eval.GetParameters(), ctIn.Degree(), ctIn.Level())
+	return opOut, eval.ZeroSlots(ctIn, start, length, opOut)
+}
+
+// ZeroSlots sets ctIn's slots in [start, start+length) to (approximately) zero, leaving every other
+// slot unchanged, and returns the result in opOut. It does so by multiplying ctIn by a 0/1 mask via
+// MultByIntegerVector, so like that method opOut ends up at ctIn.Scale and one level lower, with no
+// separate Rescale call needed.
+//
+// start and start+length must be within [0, ctIn.Slots()].
+func (eval Evaluator) ZeroSlots(ctIn *rlwe.Ciphertext, start, length int, opOut *rlwe.Ciphertext) (err error) {
+
+	if start < 0 || length < 0 || start+length > ctIn.Slots() {
+		return fmt.Errorf("cannot ZeroSlots: [start, start+length) = [%d, %d) is not a valid slot range for a ciphertext with %d slots", start, start+length, ctIn.Slots())
+	}
+
+	mask := make([]int64, ctIn.Slots())
+	for i := range mask {
+		mask[i] = 1
+	}
+	for i := start; i < start+length; i++ {
+		mask[i] = 0
+	}
+
+	return eval.MultByIntegerVector(ctIn, mask, opOut)
+}
+
+// MultByiNew multiplies ctIn by the imaginary unit i and returns the result in a newly created
+// element. See MultByi.
+func (eval Evaluator) MultByiNew(ctIn *rlwe.Ciphertext) (opOut *rlwe.Ciphertext, err error) {
+	opOut = NewCiphertext(*eval.GetParameters(), ctIn.Degree(), ctIn.Level())
+	return opOut, eval.MultByi(ctIn, opOut)
+}
+
+// MultByi multiplies ctIn by the imaginary unit i and returns the result in opOut, preserving its
+// scale and level exactly: unlike Mul(ctIn, complex(0, 1), opOut), it does not encode and multiply
+// by a plaintext and needs no Rescale.
+//
+// Prior to encryption, the real and imaginary parts of a slot vector are packed as the left and
+// right halves of the plaintext polynomial's N coefficients (see Complex128ToFixedPointCRT).
+// Multiplying every slot by i maps (Re, Im) to (-Im, Re), which is exactly what multiplying the
+// polynomial by the monomial X^(N/2) does in R[X]/(X^N+1): it swaps the two coefficient halves and
+// negates the one that lands back in the left half. This method applies that monomial
+// multiplication to every ciphertext component, moving it out of the NTT domain and back in the
+// process, which is cheaper than a plaintext multiplication since it needs no base extension.
+func (eval Evaluator) MultByi(ctIn *rlwe.Ciphertext, opOut *rlwe.Ciphertext) (err error) {
+	return eval.multByMonomial(ctIn, opOut, "MultByi", func(N int) int { return N / 2 })
+}
+
+// DivByiNew divides ctIn by the imaginary unit i (equivalently, multiplies it by -i) and returns
+// the result in a newly created element. See DivByi.
+func (eval Evaluator) DivByiNew(ctIn *rlwe.Ciphertext) (opOut *rlwe.Ciphertext, err error) {
+	opOut = NewCiphertext(*eval.GetParameters(), ctIn.Degree(), ctIn.Level())
+	return opOut, eval.DivByi(ctIn, opOut)
+}
+
+// DivByi divides ctIn by the imaginary unit i (equivalently, multiplies it by -i) and returns the
+// result in opOut, preserving its scale and level exactly. See MultByi: dividing by i is the same
+// monomial trick with X^(3N/2) instead of X^(N/2), i.e. it maps (Re, Im) to (Im, -Re).
+func (eval Evaluator) DivByi(ctIn *rlwe.Ciphertext, opOut *rlwe.Ciphertext) (err error) {
+	return eval.multByMonomial(ctIn, opOut, "DivByi", func(N int) int { return 3 * N / 2 })
+}
+
+// multByMonomial implements MultByi and DivByi: it multiplies every component of ctIn by the
+// monomial X^k, with k given by exponentOf relative to the ring degree N, moving ciphertext
+// components out of the NTT domain and back in the process.
+func (eval Evaluator) multByMonomial(ctIn *rlwe.Ciphertext, opOut *rlwe.Ciphertext, opName string, exponentOf func(N int) int) (err error) {
+
+	if eval.GetParameters().RingType() == ring.ConjugateInvariant {
+		return fmt.Errorf("cannot %s: method is not supported when parameters.RingType() == ring.ConjugateInvariant", opName)
+	}
+
+	_, level, err := eval.InitOutputUnaryOp(ctIn.El(), opOut.El())
+	if err != nil {
+		return fmt.Errorf("cannot %s: %w", opName, err)
+	}
+
+	opOut.Resize(ctIn.Degree(), level)
+
+	ringQ := eval.GetParameters().RingQ().AtLevel(level)
+
+	k := exponentOf(ringQ.N())
+
+	for i := range ctIn.Value {
+		ringQ.INTT(ctIn.Value[i], eval.buffQ[0])
+		ringQ.MultByMonomial(eval.buffQ[0], k, eval.buffQ[0])
+		ringQ.NTT(eval.buffQ[0], opOut.Value[i])
+	}
+
+	*opOut.MetaData = *ctIn.MetaData
+
+	return
+}
+
+// ModRaiseNew returns a new Ciphertext whose RNS basis has been extended from ctIn's current level to
+// params.MaxLevel(). See ModRaise: this does not, by itself, refresh ctIn or preserve the values it
+// decrypts to.
+func (eval Evaluator) ModRaiseNew(ctIn *rlwe.Ciphertext) (opOut *rlwe.Ciphertext, err error) {
+	opOut = NewCiphertext(*eval.GetParameters(), ctIn.Degree(), eval.GetParameters().MaxLevel())
+	return opOut, eval.ModRaise(ctIn, opOut)
+}
+
+// ModRaise extends the RNS basis of ctIn from its current level to params.MaxLevel() and writes the
+// result to opOut.
+//
+// For each missing modulus, ModRaise recovers the centered integer representative of ctIn's
+// coefficients modulo its current modulus chain and reduces it modulo the new moduli, using the same
+// CRT basis extension that ring.BasisExtender.ModUpQtoP uses to extend a polynomial's basis from Q to
+// QP: here, the "Q" basis is the moduli already present in ctIn and the "P" basis is the moduli still
+// missing up to params.MaxLevel(), which is a valid use of BasisExtender since it operates on any two
+// coprime RNS bases, as schemes/bgv.Evaluator's basisExtenderQ1toQ2 field already relies on to extend
+// between RingQ and the unrelated RingQMul.
+//
+// This is the same RNS mechanics as bootstrapping.Evaluator.ModUp (which raises a ciphertext from
+// level 0 to the bootstrapping modulus), generalized to an arbitrary starting level, and it shares
+// ModUp's caveat: decrypting opOut under the extended modulus does not, by itself, recover ctIn's
+// original message. Reducing ctIn modulo its own, smaller modulus loses the multiple of that modulus
+// the full decryption phase actually wrapped around by, so re-expanding the basis reintroduces that
+// multiple as an error term that swamps the message. ModRaise only guarantees that opOut's residues
+// agree with ctIn's on every modulus ctIn already had (e.g. dropping opOut back to ctIn's level with
+// Evaluator.DropLevel recovers ctIn's coefficients exactly); turning it into an actual level refresh
+// requires removing that error term homomorphically, e.g. via the CoeffsToSlots/EvalMod/SlotsToCoeffs
+// steps bootstrapping.Evaluator.Bootstrap runs after ModUp, or, for additively-structured data, by
+// combining opOut with a freshly re-encrypted value that cancels it at the application layer.
+func (eval Evaluator) ModRaise(ctIn *rlwe.Ciphertext, opOut *rlwe.Ciphertext) (err error) {
+
+	params := eval.GetParameters()
+
+	levelQ := ctIn.Level()
+	maxLevel := params.MaxLevel()
+
+	if levelQ > maxLevel {
+		return fmt.Errorf("cannot ModRaise: ctIn.Level()=%d > params.MaxLevel()=%d", levelQ, maxLevel)
+	}
+
+	opOut.Resize(ctIn.Degree(), maxLevel)
+
+	if levelQ == maxLevel {
+		for i := range ctIn.Value {
+			opOut.Value[i].CopyLvl(maxLevel, ctIn.Value[i])
+		}
+		*opOut.MetaData = *ctIn.MetaData
+		return
+	}
+
+	ringQ := params.RingQ()
+
+	missingModuli := ringQ.ModuliChain()[levelQ+1 : maxLevel+1]
+
+	ringMissing, err := ring.NewRingFromType(ringQ.N(), missingModuli, params.RingType())
+	if err != nil {
+		return fmt.Errorf("cannot ModRaise: %w", err)
+	}
+
+	be := ring.NewBasisExtender(ringQ, ringMissing)
+
+	buffQ := ringQ.AtLevel(levelQ).NewPoly()
+	buffMissing := ringMissing.NewPoly()
+
+	for i := range ctIn.Value {
+
+		ringQ.AtLevel(levelQ).INTT(ctIn.Value[i], buffQ)
+
+		be.ModUpQtoP(levelQ, len(missingModuli)-1, buffQ, buffMissing)
+
+		ringMissing.NTT(buffMissing, buffMissing)
+
+		opOut.Value[i].CopyLvl(levelQ, ctIn.Value[i])
+
+		for j := range missingModuli {
+			copy(opOut.Value[i].Coeffs[levelQ+1+j], buffMissing.Coeffs[j])
+		}
+	}
+
+	*opOut.MetaData = *ctIn.MetaData
+
+	return
+}
+
+// BroadcastRowNew broadcasts a row vector across all rows of a row-major rows*cols packed matrix and
+// returns the result in a newly allocated Ciphertext. See BroadcastRow.
+func (eval Evaluator) BroadcastRowNew(ctIn *rlwe.Ciphertext, rows, cols int) (opOut *rlwe.Ciphertext, err error) {
+	opOut = NewCiphertext(*eval.GetParameters(), ctIn.Degree(), ctIn.Level())
+	return opOut, eval.BroadcastRow(ctIn, rows, cols, opOut)
+}
+
+// BroadcastRow broadcasts a row vector across all rows of a row-major rows*cols packed matrix and
+// writes the result to opOut: ctIn is expected to hold the row vector to broadcast in its first cols
+// slots (one row), with the remaining (rows-1)*cols slots zero, and opOut's every row is set to that
+// same row vector. It is Replicate with batchSize=cols, n=rows, named for this use case.
+//
+// This method requires the rlwe.GaloisKeys returned by GaloisElementsForReplicate(cols, rows).
+func (eval Evaluator) BroadcastRow(ctIn *rlwe.Ciphertext, rows, cols int, opOut *rlwe.Ciphertext) (err error) {
+	if err = eval.Replicate(ctIn, cols, rows, opOut); err != nil {
+		return fmt.Errorf("cannot BroadcastRow: %w", err)
+	}
+	return
+}
+
+// BroadcastColumnNew broadcasts a column vector across all columns of a row-major rows*cols packed
+// matrix and returns the result in a newly allocated Ciphertext. See BroadcastColumn.
+func (eval Evaluator) BroadcastColumnNew(ctIn *rlwe.Ciphertext, rows, cols int) (opOut *rlwe.Ciphertext, err error) {
+	opOut = NewCiphertext(*eval.GetParameters(), ctIn.Degree(), ctIn.Level())
+	return opOut, eval.BroadcastColumn(ctIn, rows, cols, opOut)
+}
+
+// BroadcastColumn broadcasts a column vector across all columns of a row-major rows*cols packed
+// matrix and writes the result to opOut: ctIn is expected to hold, for every row, the value to
+// broadcast in that row's first slot, with the remaining cols-1 slots of each row zero, and opOut's
+// every row has all of its cols slots set to that row's value. It is Replicate with batchSize=1,
+// n=cols, named for this use case.
+//
+// This method requires the rlwe.GaloisKeys returned by GaloisElementsForReplicate(1, cols).
+func (eval Evaluator) BroadcastColumn(ctIn *rlwe.Ciphertext, rows, cols int, opOut *rlwe.Ciphertext) (err error) {
+	if err = eval.Replicate(ctIn, 1, cols, opOut); err != nil {
+		return fmt.Errorf("cannot BroadcastColumn: %w", err)
+	}
+	return
+}
+
 func (eval Evaluator) evaluateInPlace(level int, c0 *rlwe.Ciphertext, c1 *rlwe.Element[ring.Poly], opOut *rlwe.Ciphertext, evaluate func(ring.Poly, ring.Poly, ring.Poly)) {
 
 	var tmp0, tmp1 *rlwe.Ciphertext
@@ -601,6 +950,44 @@ func (eval Evaluator) RescaleTo(op0 *rlwe.Ciphertext, minScale rlwe.Scale, opOut
 	return nil
 }
 
+// RescaleBatch calls RescaleTo(ct, rlwe.NewScale(threshold), ct) on every ciphertext of cts, in place.
+// It returns an error if any of the calls to RescaleTo returns an error, or if the ciphertexts of cts
+// do not all end up at the same level, which would otherwise silently break the lock-step invariant
+// expected by callers that process cts as a batch.
+func (eval Evaluator) RescaleBatch(cts []*rlwe.Ciphertext, threshold float64) (err error) {
+
+	if len(cts) == 0 {
+		return
+	}
+
+	minScale := rlwe.NewScale(threshold)
+
+	for i, ct := range cts {
+		if err = eval.RescaleTo(ct, minScale, ct); err != nil {
+			return fmt.Errorf("cannot RescaleBatch: cts[%d]: %w", i, err)
+		}
+	}
+
+	level := cts[0].Level()
+	for i, ct := range cts[1:] {
+		if ct.Level() != level {
+			return fmt.Errorf("cannot RescaleBatch: cts[%d] ended at level %d, want %d", i+1, ct.Level(), level)
+		}
+	}
+
+	return
+}
+
+// RemainingMultiplications returns the number of additional MulRelin-then-Rescale operations that
+// can still be applied on ct before its level reaches 0, i.e. how much multiplicative budget ct
+// has left before it must be bootstrapped to continue the computation. This is ct.Level() divided
+// by the number of levels consumed per rescaling (see Parameters.LevelsConsumedPerRescaling),
+// following the same accounting as Parameters.MaxDepth, but evaluated at ct's current level
+// instead of the parameters' maximum level.
+func (eval Evaluator) RemainingMultiplications(ct *rlwe.Ciphertext) uint64 {
+	return uint64(ct.Level() / eval.GetParameters().LevelsConsumedPerRescaling())
+}
+
 // MulNew multiplies op0 with op1 without relinearization and returns the result in a newly created element opOut.
 //
 // op1.(type) can be
@@ -758,6 +1145,10 @@ func (eval Evaluator) MulRelinNew(op0 *rlwe.Ciphertext, op1 rlwe.Operand) (opOut
 //
 // Passing an invalid type will return an error.
 //
+// opOut.Scale is always set to op0.Scale * op1.Scale, including when op1 is a *rlwe.Plaintext:
+// there is no need to call opOut.Scale = op0.Scale.Mul(op1.Scale) manually before or after the call,
+// this tracking is not specific to the Ciphertext x Ciphertext case.
+//
 // The procedure will return an error if either op0.Degree or op1.Degree > 1.
 // The procedure will return an error if opOut.Degree != op0.Degree + op1.Degree.
 // The procedure will return an error if the evaluator was not created with an relinearization key.
@@ -1177,6 +1568,47 @@ func (eval Evaluator) mulRelinThenAdd(op0 *rlwe.Ciphertext, op1 *rlwe.Element[ri
 	return
 }
 
+// PowerSeries returns [ct^1, ct^2, ..., ct^n], computed by he.PowerBasis.GenPower: each power reuses
+// the lower powers and squarings implied by its binary decomposition (see he.SplitDegree), rather than
+// being raised independently, so evaluating several polynomials that share the base ct does not redo
+// the work of computing the powers they have in common.
+//
+// Every returned ciphertext is relinearized, so the receiver Evaluator must have been created with a
+// rlwe.RelinearizationKey (see WithKey). The powers are generated at their natural level (the level
+// after the multiplications their binary decomposition implies, which decreases with n), then all
+// dropped to the lowest level among them via DropLevel, so that the returned ciphertexts can be fed
+// interchangeably to code that expects every term of a power basis to sit at the same level.
+func (eval Evaluator) PowerSeries(ct *rlwe.Ciphertext, n int) (powers []*rlwe.Ciphertext, err error) {
+
+	if n < 1 {
+		return nil, fmt.Errorf("cannot PowerSeries: n must be >= 1 but is %d", n)
+	}
+
+	pb := he.NewPowerBasis(ct, bignum.Monomial)
+
+	for k := 1; k <= n; k++ {
+		if err = pb.GenPower(k, false, eval); err != nil {
+			return nil, fmt.Errorf("cannot PowerSeries: %w", err)
+		}
+	}
+
+	minLevel := pb.Value[1].Level()
+	for k := 2; k <= n; k++ {
+		if lvl := pb.Value[k].Level(); lvl < minLevel {
+			minLevel = lvl
+		}
+	}
+
+	powers = make([]*rlwe.Ciphertext, n)
+	for k := 1; k <= n; k++ {
+		ct := pb.Value[k]
+		eval.DropLevel(ct, ct.Level()-minLevel)
+		powers[k-1] = ct
+	}
+
+	return powers, nil
+}
+
 // RelinearizeNew applies the relinearization procedure on op0 and returns the result in a newly
 // created Ciphertext. The input Ciphertext must be of degree two.
 func (eval Evaluator) RelinearizeNew(op0 *rlwe.Ciphertext) (opOut *rlwe.Ciphertext, err error) {
@@ -1206,6 +1638,64 @@ func (eval Evaluator) Rotate(op0 *rlwe.Ciphertext, k int, opOut *rlwe.Ciphertext
 	return
 }
 
+// RotateColumns is an alias for Rotate, provided so that Evaluator satisfies the
+// he.EvaluatorWithRotations interface under the name that BGV and BFV use for the same operation.
+func (eval Evaluator) RotateColumns(op0 *rlwe.Ciphertext, k int, opOut *rlwe.Ciphertext) (err error) {
+	return eval.Rotate(op0, k, opOut)
+}
+
+// RotateColumnsComposedNew rotates the columns of op0 by k positions to the left, like RotateNew, but
+// by composing power-of-two rotations instead of requiring a GaloisKey for the exact amount k. See
+// RotateColumnsComposed.
+func (eval Evaluator) RotateColumnsComposedNew(op0 *rlwe.Ciphertext, k int) (opOut *rlwe.Ciphertext, err error) {
+	opOut = NewCiphertext(*eval.GetParameters(), op0.Degree(), op0.Level())
+	return opOut, eval.RotateColumnsComposed(op0, k, opOut)
+}
+
+// RotateColumnsComposed rotates the columns of op0 by k positions to the left and returns the result
+// in opOut, like Rotate, but builds the rotation from a sequence of power-of-two rotations instead of
+// requiring a GaloisKey for the exact amount k: k is reduced modulo the number of slots and decomposed
+// into its binary representation, and one Rotate call is issued per set bit, each consuming only the
+// GaloisKey for that power of two.
+//
+// This is useful when the evaluator only has power-of-two GaloisKeys available (e.g. generated with
+// params.GaloisElements for the rotations 1, 2, 4, ..., Slots()/2), since it avoids
+// generating and storing a dedicated key for every rotation amount that is actually needed. The trade-off
+// is noise: each composed rotation performs one independent key-switch per set bit of k, so the result
+// carries more noise than a direct Rotate with a dedicated key for k, and the method is slower than a
+// single Rotate by roughly the number of set bits in k.
+//
+// The method returns an error if k reduces to a nonzero value whose binary decomposition requires a
+// power-of-two GaloisKey that has not been added to the evaluator.
+func (eval Evaluator) RotateColumnsComposed(op0 *rlwe.Ciphertext, k int, opOut *rlwe.Ciphertext) (err error) {
+
+	slots := op0.Slots()
+
+	k %= slots
+	if k < 0 {
+		k += slots
+	}
+
+	if k == 0 {
+		if opOut != op0 {
+			opOut.Copy(op0)
+		}
+		return
+	}
+
+	ctIn := op0
+	for step := 1; step <= k; step <<= 1 {
+		if k&step != 0 {
+			if err = eval.Rotate(ctIn, step, opOut); err != nil {
+				return fmt.Errorf("cannot RotateColumnsComposed: missing GaloisKey for power-of-two step %d: %w", step, err)
+			}
+			ctIn = opOut
+		}
+	}
+
+	return
+}
+
 // ConjugateNew conjugates op0 (which is equivalent to a row rotation) and returns the result in a newly created element.
 // The method will return an error if the evaluator hasn't been given an evaluation key set with the appropriate GaloisKey.
 func (eval Evaluator) ConjugateNew(op0 *rlwe.Ciphertext) (opOut *rlwe.Ciphertext, err error) {
@@ -1276,6 +1766,7 @@ func (eval Evaluator) ShallowCopy() *Evaluator {
 		Encoder:          eval.Encoder.ShallowCopy(),
 		Evaluator:        eval.Evaluator.ShallowCopy(),
 		evaluatorBuffers: newEvaluatorBuffers(*eval.GetParameters()),
+		debug:            eval.debug,
 	}
 }
 
@@ -1286,5 +1777,72 @@ func (eval Evaluator) WithKey(evk rlwe.EvaluationKeySet) *Evaluator {
 		Evaluator:        eval.Evaluator.WithKey(evk),
 		Encoder:          eval.Encoder,
 		evaluatorBuffers: eval.evaluatorBuffers,
+		debug:            eval.debug,
+	}
+}
+
+// WithScaleChecks returns a shallow copy of the receiver Evaluator, sharing its temporary buffers,
+// with scale-tracking assertions toggled according to enable. When enabled, Add and Sub run
+// CheckScales on their ciphertext-ciphertext operands before evaluating, turning a wrong-scale
+// operand into an explicit error instead of a silent, possibly lossy, automatic rescale.
+//
+// By default, i.e. without calling this method, these checks are disabled.
+func (eval Evaluator) WithScaleChecks(enable bool) *Evaluator {
+	return &Evaluator{
+		Encoder:          eval.Encoder,
+		Evaluator:        eval.Evaluator,
+		evaluatorBuffers: eval.evaluatorBuffers,
+		debug:            enable,
+	}
+}
+
+// CheckScales returns an error describing the first scale mismatch it finds among ops, or nil if
+// ops has fewer than two elements or all of its elements share the same scale.
+func (eval Evaluator) CheckScales(ops ...*rlwe.Ciphertext) (err error) {
+	for i := 1; i < len(ops); i++ {
+		if ops[i].Scale.Cmp(ops[0].Scale) != 0 {
+			return fmt.Errorf("scale mismatch: ops[0].Scale = %f, ops[%d].Scale = %f", ops[0].Scale.Float64(), i, ops[i].Scale.Float64())
+		}
 	}
+	return
+}
+
+// EvaluatorPool holds numWorkers Evaluators, each a ShallowCopy of a common base Evaluator and
+// therefore each with its own private scratch buffers, so that independent ciphertext operations
+// can safely be run concurrently across goroutines by drawing an Evaluator from the pool instead of
+// sharing a single one. A single Evaluator instance is not thread-safe: its methods mutate the
+// evaluatorBuffers held in its evaluatorBuffers field, so calling them concurrently on the same
+// instance races on those buffers and produces incorrect results.
+type EvaluatorPool struct {
+	evaluators chan *Evaluator
+}
+
+// NewEvaluatorParallel creates an EvaluatorPool of numWorkers Evaluators for the given parameters
+// and evaluation keys, suitable for evaluating numWorkers independent ciphertext operations
+// concurrently via Batch. All Evaluators in the pool share the same read-only keys and internal
+// tables, only their scratch buffers are private.
+func NewEvaluatorParallel(params Parameters, evk rlwe.EvaluationKeySet, numWorkers int) *EvaluatorPool {
+
+	eval := NewEvaluator(params, evk)
+
+	evaluators := make(chan *Evaluator, numWorkers)
+	for i := 0; i < numWorkers; i++ {
+		if i == 0 {
+			evaluators <- eval
+			continue
+		}
+		evaluators <- eval.ShallowCopy()
+	}
+
+	return &EvaluatorPool{evaluators: evaluators}
+}
+
+// Batch draws an Evaluator from the pool, blocking until one is available, runs f on it, and
+// returns it to the pool once f returns. Calls to Batch from different goroutines run concurrently
+// on distinct Evaluators and do not race each other; f must not retain the *Evaluator it is given
+// beyond its own execution, since the same instance is handed out again to a later caller of Batch.
+func (p *EvaluatorPool) Batch(f func(eval *Evaluator)) {
+	eval := <-p.evaluators
+	defer func() { p.evaluators <- eval }()
+	f(eval)
 }
@@ -258,6 +258,12 @@ func (p Parameters) QLvl(level int) *big.Int {
 //
 // Note that when using the ConjugateInvariant variant of the scheme, the conjugate is
 // dropped and the matrix becomes an 1xN matrix.
+//
+// Evaluator.Rotate is defined as Evaluator.Automorphism(ct, GaloisElementForRotation(k), ctOut); custom
+// linear transforms that need to work at the Galois-element level directly (e.g. to deduplicate or
+// compose automorphisms before generating keys for them) can call Automorphism with this element
+// instead of going through Rotate. ModInvGaloisElement(GaloisElementForRotation(k)) is
+// GaloisElementForRotation(-k).
 func (p Parameters) GaloisElementForRotation(k int) uint64 {
 	return p.Parameters.GaloisElement(k)
 }
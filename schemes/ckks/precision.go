@@ -55,6 +55,22 @@ func (prec PrecisionStats) String() string {
 		prec.MedianPrecision.Real, prec.MedianPrecision.Imag, prec.MedianPrecision.L2)
 }
 
+// Precision decrypts ct and compares it against the expected plaintext values want (a []complex128 or
+// []float64), returning the estimated number of bits of precision remaining in ct, taken as the
+// L2-distance mean precision reported by GetPrecisionStats. It is a convenience for quickly checking a
+// ciphertext's remaining precision budget, e.g. while tuning parameters, without building a full
+// PrecisionStats by hand.
+func Precision(params Parameters, encoder *Encoder, decryptor *rlwe.Decryptor, ct *rlwe.Ciphertext, want interface{}) (prec float64, err error) {
+
+	if ct == nil {
+		return 0, fmt.Errorf("cannot Precision: ct is nil")
+	}
+
+	prec, _ = GetPrecisionStats(params, encoder, decryptor, want, ct, 0, false).MeanPrecision.L2.Float64()
+
+	return prec, nil
+}
+
 // GetPrecisionStats generates a PrecisionStats struct from the reference values and the decrypted values
 // vWant.(type) must be either []complex128 or []float64
 // element.(type) must be either *Plaintext, *Ciphertext, []complex128 or []float64. If not *Ciphertext, then decryptor can be nil.
@@ -0,0 +1,83 @@
+package ckks
+
+import (
+	"fmt"
+
+	"github.com/tuneinsight/lattigo/v5/utils"
+)
+
+// securityBoundsLogQP gives, for each supported classical security level and ring degree
+// (indexed by LogN), the largest total bit-size of Q*P that the Homomorphic Encryption
+// Security Standard (https://homomorphicencryption.org/standard/) associates with that
+// security level for a ternary secret, i.e. this package's default secret distribution
+// rlwe.DefaultXs. These are the same bounds against which this package's hand-picked example
+// parameter literals are vetted (e.g. ExampleParameters128BitLogN14LogQP438 sits just under
+// the LogN=14 bound below).
+var securityBoundsLogQP = map[int]map[int]int{
+	128: {10: 27, 11: 54, 12: 109, 13: 218, 14: 438, 15: 881, 16: 1761},
+	192: {10: 19, 11: 37, 12: 75, 13: 152, 14: 305, 15: 611, 16: 1228},
+	256: {10: 14, 11: 29, 12: 58, 13: 118, 14: 237, 15: 476, 16: 953},
+}
+
+// NewParametersForSecurity returns a set of CKKS parameters, without bootstrapping support,
+// such that:
+//   - it provides at least 2^logSlots slots,
+//   - its modulus chain supports multDepth consecutive rescaling multiplications,
+//   - the total bit-size of Q*P stays within the securityBoundsLogQP bound for securityBits.
+//
+// securityBits must be one of 128, 192 or 256. LogN is picked as the smallest value in
+// [10, 16] meeting both the slot and security requirements; an error is returned if no such
+// LogN exists, i.e. if multDepth is too large to fit under the security bound even at LogN=16.
+//
+// This picks parameters by table lookup against the published security bound, the same way
+// this package's own example parameter literals were chosen, rather than by running a lattice
+// security estimator, and the chosen moduli are not otherwise tuned for any particular circuit.
+//
+// This function never returns bootstrapping parameters: this package's bootstrapping parameter
+// literals (see he/hefloat/bootstrapping.DefaultParametersSparse and DefaultParametersDense)
+// are hand-tuned per use case rather than derived from a formula, so there is no formula here
+// to synthesize a trustworthy one from securityBits, logSlots and multDepth alone. Callers whose
+// multDepth does not fit in a non-bootstrapped chain should pick one of those default
+// bootstrapping parameter sets instead, or otherwise reduce multDepth.
+func NewParametersForSecurity(securityBits, logSlots, multDepth int) (Parameters, error) {
+
+	bounds, ok := securityBoundsLogQP[securityBits]
+	if !ok {
+		return Parameters{}, fmt.Errorf("cannot NewParametersForSecurity: securityBits must be 128, 192 or 256, but is %d", securityBits)
+	}
+
+	if multDepth < 0 {
+		return Parameters{}, fmt.Errorf("cannot NewParametersForSecurity: multDepth must be >= 0 but is %d", multDepth)
+	}
+
+	const (
+		firstPrimeBits = 55 // headroom above the default scale for the message's integer part
+		primeBits      = 45 // matches this package's LogDefaultScale in ExampleParameters128BitLogN14LogQP438
+		pPrimeBits     = 55
+	)
+
+	logQP := firstPrimeBits + multDepth*primeBits + pPrimeBits
+
+	minLogN := utils.Max(10, logSlots+1)
+
+	for logN := minLogN; logN <= 16; logN++ {
+
+		if bound, ok := bounds[logN]; ok && logQP <= bound {
+
+			logQ := make([]int, multDepth+1)
+			logQ[0] = firstPrimeBits
+			for i := 1; i < len(logQ); i++ {
+				logQ[i] = primeBits
+			}
+
+			return NewParametersFromLiteral(ParametersLiteral{
+				LogN:            logN,
+				LogQ:            logQ,
+				LogP:            []int{pPrimeBits},
+				LogDefaultScale: primeBits,
+			})
+		}
+	}
+
+	return Parameters{}, fmt.Errorf("cannot NewParametersForSecurity: no LogN in [%d, 16] fits multDepth=%d under the %d-bit security bound on logQP", minLogN, multDepth, securityBits)
+}
@@ -0,0 +1,34 @@
+package ckks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewParametersForSecurity(t *testing.T) {
+
+	t.Run("MeetsRequirements", func(t *testing.T) {
+
+		const securityBits = 128
+		const logSlots = 12
+		const multDepth = 4
+
+		params, err := NewParametersForSecurity(securityBits, logSlots, multDepth)
+		require.NoError(t, err)
+
+		require.GreaterOrEqual(t, params.LogMaxSlots(), logSlots)
+		require.Equal(t, multDepth, params.MaxLevel())
+		require.LessOrEqual(t, int(params.LogQP()), securityBoundsLogQP[securityBits][params.LogN()])
+	})
+
+	t.Run("InvalidSecurityLevel", func(t *testing.T) {
+		_, err := NewParametersForSecurity(100, 12, 4)
+		require.Error(t, err)
+	})
+
+	t.Run("DepthTooLargeForAnySupportedLogN", func(t *testing.T) {
+		_, err := NewParametersForSecurity(128, 12, 1000)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,72 @@
+package bignum
+
+import "math/big"
+
+// CRTReconstruct reconstructs a slice of *big.Int from its RNS/CRT representation: coeffsRNS[i][j]
+// is the j-th coefficient reduced modulo moduli[i]. The returned coefficients are reduced modulo the
+// product of moduli (i.e. in [0, prod(moduli)-1]).
+//
+// This is a context-independent counterpart to ring.Ring's PolyToBigint/SetCoefficientsBigint, useful
+// for checking ring arithmetic against a big.Int reference implementation without reaching into a
+// ring.Ring's internal state.
+func CRTReconstruct(coeffsRNS [][]uint64, moduli []uint64) (coeffs []*big.Int) {
+
+	modulus := new(big.Int).SetUint64(1)
+	for _, qi := range moduli {
+		modulus.Mul(modulus, new(big.Int).SetUint64(qi))
+	}
+
+	crtReconstruction := make([]*big.Int, len(moduli))
+
+	QiB := new(big.Int)
+	tmp := new(big.Int)
+	for i, qi := range moduli {
+		QiB.SetUint64(qi)
+		crtReconstruction[i] = new(big.Int).Quo(modulus, QiB)
+		tmp.ModInverse(crtReconstruction[i], QiB)
+		tmp.Mod(tmp, QiB)
+		crtReconstruction[i].Mul(crtReconstruction[i], tmp)
+	}
+
+	n := len(coeffsRNS[0])
+
+	coeffs = make([]*big.Int, n)
+
+	for j := 0; j < n; j++ {
+
+		coeffs[j] = new(big.Int)
+
+		for i := range moduli {
+			tmp.Mul(NewInt(coeffsRNS[i][j]), crtReconstruction[i])
+			coeffs[j].Add(coeffs[j], tmp)
+		}
+
+		coeffs[j].Mod(coeffs[j], modulus)
+	}
+
+	return
+}
+
+// CRTDecompose is the inverse of CRTReconstruct: it reduces each of coeffs modulo each of moduli,
+// returning the RNS/CRT representation coeffsRNS such that CRTReconstruct(coeffsRNS, moduli) is
+// equal to coeffs reduced modulo the product of moduli.
+func CRTDecompose(coeffs []*big.Int, moduli []uint64) (coeffsRNS [][]uint64) {
+
+	coeffsRNS = make([][]uint64, len(moduli))
+
+	QiB := new(big.Int)
+	tmp := new(big.Int)
+
+	for i, qi := range moduli {
+
+		QiB.SetUint64(qi)
+
+		coeffsRNS[i] = make([]uint64, len(coeffs))
+
+		for j, c := range coeffs {
+			coeffsRNS[i][j] = tmp.Mod(c, QiB).Uint64()
+		}
+	}
+
+	return
+}
@@ -0,0 +1,40 @@
+package bignum
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRTReconstruct(t *testing.T) {
+
+	moduli := []uint64{97, 101, 103}
+
+	want := []*big.Int{NewInt(0), NewInt(1), NewInt(12345), NewInt(97*101*103 - 1)}
+
+	coeffsRNS := CRTDecompose(want, moduli)
+
+	have := CRTReconstruct(coeffsRNS, moduli)
+
+	require.Equal(t, len(want), len(have))
+
+	for i := range want {
+		require.Zerof(t, want[i].Cmp(have[i]), "CRTReconstruct(CRTDecompose(x)) != x at index %d", i)
+	}
+}
+
+func BenchmarkCRTReconstruct(b *testing.B) {
+	moduli := []uint64{0xffffffffffc0001, 0x10000000006e0001, 0xfffffffff00001, 0xffffffffd8a0001}
+
+	coeffs := make([]*big.Int, 1<<14)
+	for i := range coeffs {
+		coeffs[i] = NewInt(uint64(i))
+	}
+
+	coeffsRNS := CRTDecompose(coeffs, moduli)
+
+	for i := 0; i < b.N; i++ {
+		CRTReconstruct(coeffsRNS, moduli)
+	}
+}
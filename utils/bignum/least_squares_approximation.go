@@ -0,0 +1,179 @@
+package bignum
+
+import (
+	"math/big"
+)
+
+// LeastSquaresApproximation is the result of a Chebyshev-basis polynomial
+// approximation computed by ApproximateLeastSquares.
+type LeastSquaresApproximation struct {
+	Polynomial
+
+	// Error is the root-mean-square error of the fitted polynomial, measured
+	// over the sample points used to compute it.
+	Error *big.Float
+}
+
+// ApproximateLeastSquares computes a Chebyshev-basis polynomial approximation of the input
+// function f over interval, by fitting the coefficients with a least-squares regression over
+// numSamples uniformly spaced points, instead of interpolating at Chebyshev nodes as
+// ChebyshevApproximation does. This trades exactness at a handful of nodes for an approximation
+// that is more robust to functions with sharp features (e.g. ReLU), for which Chebyshev-node
+// interpolation tends to oscillate (Runge's phenomenon).
+//
+// f.(type) can be either:
+//   - func(Complex128)Complex128
+//   - func(float64)float64
+//   - func(*big.Float)*big.Float
+//   - func(*Complex)*Complex
+//
+// weights, if not nil, must have length numSamples and scales each sample's contribution to the
+// regression, e.g. to emphasize a sub-region of the interval. If nil, all samples are weighted
+// equally.
+//
+// interval.Nodes is the degree of the fitted polynomial, and numSamples must be greater or
+// equal to interval.Nodes+1.
+//
+// The reference precision is taken from the values stored in the Interval struct.
+//
+// The returned LeastSquaresApproximation embeds a Polynomial with Basis Chebyshev, which can be
+// passed wherever a Polynomial produced by ChebyshevApproximation is accepted. Its Error field
+// holds the root-mean-square error of the fit, measured over the sample points.
+func ApproximateLeastSquares(f interface{}, interval Interval, numSamples int, weights []float64) (approx LeastSquaresApproximation) {
+
+	degree := interval.Nodes
+
+	if numSamples < degree+1 {
+		panic("cannot ApproximateLeastSquares: numSamples must be greater or equal to interval.Nodes+1")
+	}
+
+	if weights != nil && len(weights) != numSamples {
+		panic("cannot ApproximateLeastSquares: len(weights) must be equal to numSamples")
+	}
+
+	prec := interval.A.Prec()
+
+	var fCmplx func(*Complex) *Complex
+
+	switch f := f.(type) {
+	case func(x complex128) (y complex128):
+		fCmplx = func(x *Complex) (y *Complex) {
+			yCmplx := f(x.Complex128())
+			return &Complex{new(big.Float).SetFloat64(real(yCmplx)), new(big.Float).SetFloat64(imag(yCmplx))}
+		}
+	case func(x float64) (y float64):
+		fCmplx = func(x *Complex) (y *Complex) {
+			xf64, _ := x[0].Float64()
+			return &Complex{new(big.Float).SetFloat64(f(xf64)), new(big.Float)}
+		}
+	case func(x *big.Float) (y *big.Float):
+		fCmplx = func(x *Complex) (y *Complex) {
+			return &Complex{f(x[0]), new(big.Float)}
+		}
+	case func(x *Complex) *Complex:
+		fCmplx = f
+	}
+
+	// samples are numSamples uniformly spaced points over [a, b], including both endpoints.
+	samples := make([]*big.Float, numSamples)
+	step := new(big.Float).Sub(&interval.B, &interval.A)
+	step.Quo(step, new(big.Float).SetInt64(int64(numSamples-1)))
+	for k := range samples {
+		xk := new(big.Float).SetPrec(prec).SetInt64(int64(k))
+		xk.Mul(xk, step)
+		xk.Add(xk, &interval.A)
+		samples[k] = xk
+	}
+
+	// basis[k] holds [T_0(samples[k]), ..., T_degree(samples[k])] and targets[k] holds
+	// f(samples[k]).
+	basis := make([][]*big.Float, numSamples)
+	targets := make([]*Complex, numSamples)
+
+	x := NewComplex()
+	x.SetPrec(prec)
+
+	for k, xk := range samples {
+
+		row := make([]*big.Float, degree+1)
+		for i := range row {
+			row[i] = new(big.Float).SetPrec(prec)
+		}
+		chebyshevBasisInPlace(degree+1, xk, interval, row)
+		basis[k] = row
+
+		x[0].Set(xk)
+		targets[k] = fCmplx(x)
+	}
+
+	// Builds the weighted normal equations matrix*coeffs = vector. The basis functions are
+	// real, so the same matrix is reused to solve independently for the real and imaginary
+	// parts of the coefficients.
+	matrix := make([][]*big.Float, degree+1)
+	bReal := make([]*big.Float, degree+1)
+	bImag := make([]*big.Float, degree+1)
+	for i := range matrix {
+		matrix[i] = make([]*big.Float, degree+1)
+		for j := range matrix[i] {
+			matrix[i][j] = new(big.Float).SetPrec(prec)
+		}
+		bReal[i] = new(big.Float).SetPrec(prec)
+		bImag[i] = new(big.Float).SetPrec(prec)
+	}
+
+	tmp := new(big.Float).SetPrec(prec)
+
+	for k := 0; k < numSamples; k++ {
+
+		w := NewFloat(1.0, prec)
+		if weights != nil {
+			w = NewFloat(weights[k], prec)
+		}
+
+		for i := 0; i <= degree; i++ {
+
+			wi := new(big.Float).Mul(w, basis[k][i])
+
+			for j := 0; j <= degree; j++ {
+				matrix[i][j].Add(matrix[i][j], tmp.Mul(wi, basis[k][j]))
+			}
+
+			bReal[i].Add(bReal[i], tmp.Mul(wi, targets[k][0]))
+			bImag[i].Add(bImag[i], tmp.Mul(wi, targets[k][1]))
+		}
+	}
+
+	matrixImag := make([][]*big.Float, len(matrix))
+	for i := range matrix {
+		matrixImag[i] = make([]*big.Float, len(matrix[i]))
+		for j := range matrix[i] {
+			matrixImag[i][j] = new(big.Float).Set(matrix[i][j])
+		}
+	}
+
+	solveLinearSystemInPlace(matrix, bReal)
+	solveLinearSystemInPlace(matrixImag, bImag)
+
+	coeffs := make([]*Complex, degree+1)
+	for i := range coeffs {
+		coeffs[i] = &Complex{bReal[i], bImag[i]}
+	}
+
+	pol := NewPolynomial(Chebyshev, coeffs, &interval)
+
+	// Root-mean-square error of the fit, measured over the sample points.
+	sse := new(big.Float).SetPrec(prec)
+	for k, xk := range samples {
+		y := pol.Evaluate(xk)
+		y.Sub(y, targets[k])
+		sqErr := new(big.Float).Mul(y[0], y[0])
+		sqErr.Add(sqErr, new(big.Float).Mul(y[1], y[1]))
+		sse.Add(sse, sqErr)
+	}
+	sse.Quo(sse, new(big.Float).SetInt64(int64(numSamples)))
+
+	return LeastSquaresApproximation{
+		Polynomial: pol,
+		Error:      new(big.Float).Sqrt(sse),
+	}
+}
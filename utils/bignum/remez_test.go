@@ -38,6 +38,45 @@ func TestApproximation(t *testing.T) {
 		require.InDelta(t, y0, y1, 1e-15)
 	})
 
+	t.Run("LeastSquares", func(t *testing.T) {
+
+		relu := func(x *big.Float) (y *big.Float) {
+			if x.Sign() < 0 {
+				return new(big.Float).SetPrec(x.Prec())
+			}
+			return new(big.Float).Set(x)
+		}
+
+		interval := Interval{
+			Nodes: 15,
+			A:     *NewFloat(-1, prec),
+			B:     *NewFloat(1, prec),
+		}
+
+		approx := ApproximateLeastSquares(relu, interval, 256, nil)
+
+		require.Equal(t, Chebyshev, approx.Basis)
+
+		errFloat64, _ := approx.Error.Float64()
+		require.Less(t, errFloat64, 0.05)
+
+		// A weighted fit that emphasizes the kink around 0 should stay within 0.05 of ReLU
+		// close to the kink.
+		weights := make([]float64, 256)
+		for i := range weights {
+			x := -1 + 2*float64(i)/255
+			weights[i] = 1 + 10/(1+100*x*x)
+		}
+
+		weighted := ApproximateLeastSquares(relu, interval, 256, weights)
+
+		xBig := NewFloat(0.01, prec)
+		y0, _ := relu(xBig).Float64()
+		y1, _ := weighted.Evaluate(xBig)[0].Float64()
+
+		require.InDelta(t, y0, y1, 0.05)
+	})
+
 	t.Run("MultiIntervalMinimaxRemez", func(t *testing.T) {
 
 		scanStep := NewFloat(1, prec)
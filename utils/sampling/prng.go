@@ -59,7 +59,25 @@ func (prng *KeyedPRNG) Read(sum []byte) (n int, err error) {
 	return prng.xof.Read(sum)
 }
 
-// Reset resets the PRNG to its initial state.
+// Reset resets the PRNG to the state it was in right after it was created, i.e. it will reproduce
+// the same stream of bytes from the start.
 func (prng *KeyedPRNG) Reset() {
 	prng.xof.Reset()
 }
+
+// Reseed reseeds the PRNG with key, behaving as if it had been created with NewKeyedPRNG(key):
+// calling Reseed with the same key on two PRNG instances makes them produce the same stream of bytes
+// from that point on, which is useful to replay part of a protocol execution without instantiating a
+// new PRNG.
+func (prng *KeyedPRNG) Reseed(key []byte) error {
+
+	xof, err := blake2b.NewXOF(blake2b.OutputLengthUnknown, key)
+	if err != nil {
+		return err
+	}
+
+	prng.key = key
+	prng.xof = xof
+
+	return nil
+}
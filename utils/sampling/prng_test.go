@@ -32,4 +32,33 @@ func Test_PRNG(t *testing.T) {
 		require.Equal(t, sum0, sum1)
 	})
 
+	t.Run("PRNG/ResetWithKey", func(t *testing.T) {
+
+		keyA := []byte{0x01, 0x02, 0x03}
+		keyB := []byte{0x04, 0x05, 0x06}
+
+		Ha, _ := sampling.NewKeyedPRNG(keyA)
+
+		sum0 := make([]byte, 512)
+		Ha.Read(sum0)
+
+		// Reseeding with a different key should not reproduce the stream keyA produced.
+		require.NoError(t, Ha.Reseed(keyB))
+		sum1 := make([]byte, 512)
+		Ha.Read(sum1)
+		require.NotEqual(t, sum0, sum1)
+
+		// Reseeding with keyA again should reproduce the exact same stream as a fresh NewKeyedPRNG(keyA).
+		require.NoError(t, Ha.Reseed(keyA))
+		Hb, _ := sampling.NewKeyedPRNG(keyA)
+
+		sum2 := make([]byte, 512)
+		sum3 := make([]byte, 512)
+		Ha.Read(sum2)
+		Hb.Read(sum3)
+
+		require.Equal(t, sum2, sum3)
+		require.Equal(t, sum0, sum2)
+	})
+
 }
@@ -27,6 +27,25 @@ func EqualSlice[V comparable](a, b []V) (v bool) {
 	return
 }
 
+// EqualSliceUint64ConstTime checks the equality between two []uint64 slices in constant time with
+// respect to where they might differ: unlike EqualSlice, whose short-circuiting && skips the element
+// comparisons after the first mismatch, it ORs the XOR of every pair of elements together and only compares the accumulator to zero
+// once the whole slice has been scanned. Use this when comparing secret material, such as MACs or
+// serialized keys, for which leaking the position of the first mismatch through timing would be a
+// vulnerability; use EqualSlice for comparisons that do not involve secret data.
+func EqualSliceUint64ConstTime(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	var v uint64
+	for i := range a {
+		v |= a[i] ^ b[i]
+	}
+
+	return v == 0
+}
+
 // MaxSlice returns the maximum value in the slice.
 func MaxSlice[V constraints.Ordered](slice []V) (max V) {
 	for _, c := range slice {
@@ -14,6 +14,13 @@ func TestAllDistinct(t *testing.T) {
 	require.False(t, AllDistinct([]uint64{1, 2, 3, 4, 5, 5}))
 }
 
+func TestEqualSliceUint64ConstTime(t *testing.T) {
+	require.True(t, EqualSliceUint64ConstTime([]uint64{}, []uint64{}))
+	require.True(t, EqualSliceUint64ConstTime([]uint64{1, 2, 3}, []uint64{1, 2, 3}))
+	require.False(t, EqualSliceUint64ConstTime([]uint64{1, 2, 3}, []uint64{1, 2, 4}))
+	require.False(t, EqualSliceUint64ConstTime([]uint64{1, 2, 3}, []uint64{1, 2}))
+}
+
 func TestRotateUint64(t *testing.T) {
 	s := []uint64{0, 1, 2, 3, 4, 5, 6, 7}
 	sout := make([]uint64, len(s))